@@ -3,6 +3,7 @@ package main
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 
@@ -16,6 +17,10 @@ var (
 	ΛEnumTypes map[string][]reflect.Type
 )
 
+// errSchemaNotFound is wrapped by Unmarshal when a type has no schema, so
+// that callers can test for it with errors.Is.
+var errSchemaNotFound = errors.New("schema not found")
+
 func init() {
 	SchemaTree["emptyBranchTestOne"] = &yang.Entry{
 		Name: "empty-branch-test-one",
@@ -71,7 +76,7 @@ func Unmarshal(data []byte, destStruct ygot.GoStruct, opts ...ytypes.UnmarshalOp
 	tn := reflect.TypeOf(destStruct).Elem().Name()
 	schema, ok := SchemaTree[tn]
 	if !ok {
-		return fmt.Errorf("could not find schema for type %s", tn)
+		return fmt.Errorf("%w for type %s", errSchemaNotFound, tn)
 	}
 	var jsonTree interface{}
 	if err := json.Unmarshal([]byte(data), &jsonTree); err != nil {
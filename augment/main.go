@@ -21,7 +21,7 @@ func main() {
 	iface.Bandwidth = ygot.Uint32(1000) // 1000 Mbps
 
 	// Validate the configuration
-	err := device.Validate()
+	err := network.ValidateDevice(&device)
 	if err != nil {
 		fmt.Printf("ERROR: Built instance is not valid: %v\n", err)
 		return
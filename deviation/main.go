@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	network "github.com/nleiva/go-yang-basics/pkg"
+	"github.com/nleiva/go-yang-basics/pkg/loopback"
 	"github.com/openconfig/ygot/ygot"
 )
 
@@ -15,7 +16,7 @@ func main() {
 	fmt.Println("=== Example 1: Valid Interface Name ===")
 	iface.Name = ygot.String("eth0")
 
-	err := device.Validate()
+	err := network.ValidateDevice(&device)
 	if err != nil {
 		fmt.Printf("ERROR: Built instance is not valid: %v\n", err)
 	} else {
@@ -26,7 +27,7 @@ func main() {
 	fmt.Println("\n=== Example 2: Another Valid Interface Name ===")
 	iface.Name = ygot.String("wlan1")
 
-	err = device.Validate()
+	err = network.ValidateDevice(&device)
 	if err != nil {
 		fmt.Printf("ERROR: Built instance is not valid: %v\n", err)
 	} else {
@@ -37,10 +38,37 @@ func main() {
 	fmt.Println("\n=== Example 3: Invalid Interface Name ===")
 	iface.Name = ygot.String("lo0") // loopback interface - doesn't match ethX or wlanX pattern
 
-	err = device.Validate()
+	err = network.ValidateDevice(&device)
 	if err != nil {
 		fmt.Printf("ERROR: Built instance is not valid: %v\n", err)
 	} else {
 		fmt.Println("Interface name is valid (unexpected)")
 	}
+
+	// Example 4: Names that only contain a valid name. YANG patterns must
+	// match the whole value, so ygot anchors them as ^(...)$.
+	fmt.Println("\n=== Example 4: Partially Matching Interface Names ===")
+	for _, name := range []string{"xeth0", "eth0x"} {
+		iface.Name = ygot.String(name)
+
+		err = network.ValidateDevice(&device)
+		if err != nil {
+			fmt.Printf("ERROR: Built instance is not valid: %v\n", err)
+		} else {
+			fmt.Printf("Interface name %s is valid (unexpected)\n", name)
+		}
+	}
+
+	// Example 5: Loopback names with the loopback deviation. The package
+	// generated with loopback.yang instead of deviation.yang accepts them.
+	fmt.Println("\n=== Example 5: Loopback Interface Name with loopback.yang ===")
+	loDevice := loopback.Device{}
+	loDevice.GetOrCreateInterface().Name = ygot.String("lo0")
+
+	err = loDevice.Validate()
+	if err != nil {
+		fmt.Printf("ERROR: Built instance is not valid: %v\n", err)
+	} else {
+		fmt.Println("Valid interface name: lo0")
+	}
 }
@@ -3,8 +3,27 @@ generator -path=. \
   -enum_suffix_for_simple_union_enums \
   -package_name=network -generate_fakeroot -fakeroot_name=device \
   -generate_getters \
+  -generate_leaf_getters \
   -generate_ordered_maps=false \
+  -generate_populate_defaults \
   -generate_simple_unions \
+  -yangpresence \
+  -include_descriptions \
   base.yang \
   deviation.yang \
-  augment.yang
\ No newline at end of file
+  augment.yang
+
+generator -path=. \
+  -output_file=pkg/loopback/network.go \
+  -enum_suffix_for_simple_union_enums \
+  -package_name=loopback -generate_fakeroot -fakeroot_name=device \
+  -generate_getters \
+  -generate_leaf_getters \
+  -generate_ordered_maps=false \
+  -generate_populate_defaults \
+  -generate_simple_unions \
+  -yangpresence \
+  -include_descriptions \
+  base.yang \
+  loopback.yang \
+  augment.yang
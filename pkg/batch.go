@@ -0,0 +1,43 @@
+package network
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// ValidateBatch validates every device, including ValidateConstraints, and
+// returns the errors of the ones that fail keyed by their index in devices.
+// A nil device fails with ErrValidation. The devices are validated
+// concurrently by one worker per CPU.
+func ValidateBatch(devices []*Device) map[int]error {
+	jobs := make(chan int)
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		errs = map[int]error{}
+	)
+	for w := 0; w < min(runtime.NumCPU(), len(devices)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				err := fmt.Errorf("%w: nil device", ErrValidation)
+				if devices[i] != nil {
+					err = ValidateDevice(devices[i])
+				}
+				if err != nil {
+					mu.Lock()
+					errs[i] = err
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for i := range devices {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return errs
+}
@@ -0,0 +1,59 @@
+package network
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+func TestValidateBatch(t *testing.T) {
+	valid := &Device{}
+	valid.GetOrCreateInterface().Priority = ygot.Uint8(12)
+	badPriority := &Device{}
+	badPriority.GetOrCreateInterface().Priority = ygot.Uint8(7)
+	badVlan := &Device{}
+	iface := badVlan.GetOrCreateInterface()
+	iface.Enabled = ygot.Bool(false)
+	iface.VlanId = ygot.Uint16(10)
+
+	errs := ValidateBatch([]*Device{valid, badPriority, &Device{}, badVlan, nil})
+	if len(errs) != 3 {
+		t.Fatalf("ValidateBatch() flagged %d devices, want 3: %v", len(errs), errs)
+	}
+	for _, i := range []int{1, 3, 4} {
+		if !errors.Is(errs[i], ErrValidation) {
+			t.Errorf("ValidateBatch()[%d] = %v, want ErrValidation", i, errs[i])
+		}
+	}
+}
+
+func TestValidateBatchEmpty(t *testing.T) {
+	if errs := ValidateBatch(nil); len(errs) != 0 {
+		t.Errorf("ValidateBatch(nil) = %v, want no errors", errs)
+	}
+}
+
+func BenchmarkValidateBatch(b *testing.B) {
+	devices := make([]*Device, 100)
+	for i := range devices {
+		devices[i] = &Device{}
+		iface := devices[i].GetOrCreateInterface()
+		iface.Name = ygot.String("eth0")
+		iface.Mtu = ygot.Uint16(1500)
+		iface.Priority = ygot.Uint8(12)
+	}
+
+	b.Run("serial", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			for _, d := range devices {
+				ValidateDevice(d)
+			}
+		}
+	})
+	b.Run("batch", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			ValidateBatch(devices)
+		}
+	})
+}
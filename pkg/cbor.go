@@ -0,0 +1,51 @@
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/openconfig/ygot/ygot"
+)
+
+// cborDecMode decodes CBOR maps with string keys, so the result can be
+// handled like a decoded JSON tree.
+var cborDecMode = mustDecMode(cbor.DecOptions{
+	DefaultMapType: reflect.TypeOf(map[string]interface{}{}),
+})
+
+// mustDecMode returns the decoding mode for opts, and panics if they are
+// invalid, which is a programming error.
+func mustDecMode(opts cbor.DecOptions) cbor.DecMode {
+	dm, err := opts.DecMode()
+	if err != nil {
+		panic(fmt.Sprintf("invalid CBOR decoding options: %v", err))
+	}
+	return dm
+}
+
+// EmitCBOR encodes d as CBOR. The encoded tree is the same as the RFC7951 JSON
+// representation of the device.
+func EmitCBOR(d *Device) ([]byte, error) {
+	tree, err := ygot.ConstructIETFJSON(d, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot build RFC7951 tree: %w", err)
+	}
+	return cbor.Marshal(tree)
+}
+
+// UnmarshalCBOR decodes data, as produced by EmitCBOR, into d.
+func UnmarshalCBOR(data []byte, d *Device) error {
+	var tree interface{}
+	if err := cborDecMode.Unmarshal(data, &tree); err != nil {
+		return fmt.Errorf("invalid CBOR: %w", err)
+	}
+	// Going through JSON turns CBOR integers into the float64 values the
+	// RFC7951 unmarshaler expects.
+	b, err := json.Marshal(tree)
+	if err != nil {
+		return fmt.Errorf("cannot convert CBOR to JSON: %w", err)
+	}
+	return Parse(b, d)
+}
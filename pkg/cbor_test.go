@@ -0,0 +1,38 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+func TestCBORRoundTrip(t *testing.T) {
+	d := augmentDevice()
+	iface := d.GetInterface()
+	iface.Cookie = []byte{0xca, 0xfe}
+	iface.LoadFactor = ygot.Float64(0.75)
+	iface.Ipv4Address = []string{"10.0.0.1", "10.0.0.2"}
+	iface.GetOrCreateState().GetOrCreateCounters().InOctets = ygot.Uint64(1 << 40)
+
+	data, err := EmitCBOR(d)
+	if err != nil {
+		t.Fatalf("EmitCBOR() error = %v", err)
+	}
+	got := &Device{}
+	if err := UnmarshalCBOR(data, got); err != nil {
+		t.Fatalf("UnmarshalCBOR() error = %v", err)
+	}
+	n, err := ygot.Diff(d, got)
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+	if len(n.GetUpdate()) != 0 || len(n.GetDelete()) != 0 {
+		t.Errorf("Diff() after round-trip = %v, want no differences", n)
+	}
+}
+
+func TestUnmarshalCBORInvalid(t *testing.T) {
+	if err := UnmarshalCBOR([]byte{0xff, 0x00}, &Device{}); err == nil {
+		t.Error("UnmarshalCBOR() of invalid CBOR error = nil, want an error")
+	}
+}
@@ -0,0 +1,164 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ytypes"
+)
+
+// maxMTU is the largest MTU supported by each interface type.
+var maxMTU = map[E_NetworkDevice_InterfaceType]uint16{
+	NetworkDevice_InterfaceType_ethernet: 9216,
+	NetworkDevice_InterfaceType_wifi:     2304,
+}
+
+// ValidateConstraints checks the rules of the model that ygot does not
+// enforce, such as "when" conditions and limits that depend on other leaves,
+// like the MTU of each interface type. The generated Validate method cannot be
+// extended to run it, so use ValidateDevice, which runs both, to validate a
+// device.
+func ValidateConstraints(d *Device) error {
+	var errs []error
+	if i := d.GetInterface(); i != nil {
+		// when "../enabled = 'true'"
+		if i.VlanId != nil && !i.GetEnabled() {
+			errs = append(errs, errors.New("/interface/vlan-id: only valid when /interface/enabled is true"))
+		}
+		if limit, ok := maxMTU[i.InterfaceType]; ok && i.GetMtu() > limit {
+			errs = append(errs, fmt.Errorf("/interface/mtu: %d is above the maximum of %d for a %s interface", i.GetMtu(), limit, i.InterfaceType))
+		}
+		if i.Speed != NetworkDevice_Interface_Speed_UNSET && i.GetAutoNegotiate() {
+			errs = append(errs, errors.New("/interface/speed: only valid when /interface/auto-negotiate is not true"))
+		}
+	}
+	errs = append(errs, uniqueLeafLists(d)...)
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+	return nil
+}
+
+// uniqueLeafLists reports the values repeated in the configuration leaf-lists
+// of d, which YANG requires to be unique but ygot accepts.
+func uniqueLeafLists(d *Device) []error {
+	var errs []error
+	Walk(d, func(path string, value interface{}) error {
+		_, e, err := resolvePath(path)
+		if err != nil || !e.IsLeafList() || e.ReadOnly() {
+			return nil
+		}
+		v := reflect.ValueOf(value)
+		seen := make(map[interface{}]bool, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			x := v.Index(i).Interface()
+			if seen[x] {
+				errs = append(errs, fmt.Errorf("%s: duplicate value %v", path, x))
+			}
+			seen[x] = true
+		}
+		return nil
+	})
+	return errs
+}
+
+// ValidateDevice validates d against the whole model: it runs the generated
+// Validate method, which checks the schema restrictions ygot knows about, and
+// then ValidateConstraints. Errors wrap ErrValidation. It is the check every
+// helper of this package that validates a device uses.
+func ValidateDevice(d *Device) error {
+	if err := d.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+	return ValidateConstraints(d)
+}
+
+// MissingMandatory returns the sorted paths of the leaves marked "mandatory
+// true" in the schema that are unset in d, e.g. /interface/name. The leaves
+// of a presence container are only required when it is present. Neither
+// ValidateDevice nor the generated Validate method checks them.
+func MissingMandatory(d *Device) []string {
+	var out []string
+	missingMandatory(d, SchemaTree["Device"], "", &out)
+	sort.Strings(out)
+	return out
+}
+
+// missingMandatory appends to out the paths of the mandatory leaves below e,
+// found at path, that are unset in d. Lists are not looked into.
+func missingMandatory(d *Device, e *yang.Entry, path string, out *[]string) {
+	for _, name := range dataChildren(e) {
+		child := childEntry(e, name)
+		p := path + "/" + name
+		switch {
+		case child.IsList():
+			// Each member would need checking, and no list of the model
+			// has mandatory leaves.
+		case child.IsDir():
+			if _, ok := child.Extra["presence"]; ok {
+				if _, err := Subtree(d, p); err != nil {
+					continue
+				}
+			}
+			missingMandatory(d, child, p, out)
+		case child.IsLeaf() && child.Mandatory == yang.TSTrue:
+			if set, err := IsSet(d, p); err == nil && !set {
+				*out = append(*out, p)
+			}
+		}
+	}
+}
+
+// ValidateVerbose validates d like ValidateDevice, and writes to w a line for
+// every populated leaf, in the order Walk visits them, saying whether its
+// value meets the restrictions of its schema type. A last line reports the
+// result of ValidateConstraints. It helps find which restriction a device
+// breaks.
+func ValidateVerbose(d *Device, w io.Writer) error {
+	Walk(d, func(path string, value interface{}) error {
+		_, e, err := resolvePath(path)
+		if err != nil {
+			fmt.Fprintf(w, "FAIL %s: %v\n", path, err)
+			return nil
+		}
+		// Walk dereferences scalar leaves, but ytypes validates them
+		// through a pointer, as they are held in the generated structs.
+		if t := reflect.TypeOf(value); t.PkgPath() == "" && t.Kind() != reflect.Slice {
+			p := reflect.New(t)
+			p.Elem().Set(reflect.ValueOf(value))
+			value = p.Interface()
+		}
+		if errs := ytypes.Validate(e, value); len(errs) > 0 {
+			fmt.Fprintf(w, "FAIL %s: %v\n", path, errs)
+			return nil
+		}
+		fmt.Fprintf(w, "ok   %s\n", path)
+		return nil
+	})
+	if err := ValidateConstraints(d); err != nil {
+		fmt.Fprintf(w, "FAIL constraints: %v\n", err)
+	} else {
+		fmt.Fprintln(w, "ok   constraints")
+	}
+	return ValidateDevice(d)
+}
+
+// ValidateWithWarnings validates d like the helpers of this package do, and
+// additionally reports problems that do not make d invalid as warnings, such
+// as the use of leaves listed by DeprecatedLeaves.
+func ValidateWithWarnings(d *Device) (warnings []error, err error) {
+	for _, path := range DeprecatedLeaves() {
+		set, err := IsSet(d, path)
+		if err != nil {
+			return nil, err
+		}
+		if set {
+			warnings = append(warnings, fmt.Errorf("%s: leaf is deprecated", path))
+		}
+	}
+	return warnings, ValidateDevice(d)
+}
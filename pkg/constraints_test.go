@@ -0,0 +1,198 @@
+package network
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+func TestVlanIdWhen(t *testing.T) {
+	tests := []struct {
+		desc    string
+		enabled *bool
+		wantErr bool
+	}{
+		{desc: "enabled", enabled: ygot.Bool(true)},
+		{desc: "enabled by default"},
+		{desc: "disabled", enabled: ygot.Bool(false), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			d := &Device{}
+			iface := d.GetOrCreateInterface()
+			iface.Enabled = tt.enabled
+			iface.VlanId = ygot.Uint16(100)
+
+			if err := ValidateConstraints(d); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateConstraints() error = %v, want error %v", err, tt.wantErr)
+			}
+			err := ValidateDevice(d)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateDevice() error = %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrValidation) {
+				t.Errorf("ValidateDevice() error = %v, want ErrValidation", err)
+			}
+		})
+	}
+}
+
+func TestValidateDeviceRunsSchemaValidation(t *testing.T) {
+	d := &Device{}
+	d.GetOrCreateInterface().Priority = ygot.Uint8(7)
+	if err := ValidateConstraints(d); err != nil {
+		t.Errorf("ValidateConstraints() error = %v, want nil", err)
+	}
+	if err := ValidateDevice(d); !errors.Is(err, ErrValidation) {
+		t.Errorf("ValidateDevice() error = %v, want ErrValidation", err)
+	}
+}
+
+func TestValidateWithWarnings(t *testing.T) {
+	deprecated := augmentDevice()
+	deprecated.Interface.Duplex = NetworkDevice_Interface_Duplex_full
+	warnings, err := ValidateWithWarnings(deprecated)
+	if err != nil {
+		t.Errorf("ValidateWithWarnings(duplex) error = %v, want nil", err)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0].Error(), "/interface/duplex") {
+		t.Errorf("ValidateWithWarnings(duplex) warnings = %v, want one for /interface/duplex", warnings)
+	}
+
+	outOfRange := augmentDevice()
+	outOfRange.Interface.Priority = ygot.Uint8(7)
+	warnings, err = ValidateWithWarnings(outOfRange)
+	if !errors.Is(err, ErrValidation) {
+		t.Errorf("ValidateWithWarnings(priority 7) error = %v, want ErrValidation", err)
+	}
+	if len(warnings) != 0 {
+		t.Errorf("ValidateWithWarnings(priority 7) warnings = %v, want none", warnings)
+	}
+}
+
+func TestMtuPerInterfaceType(t *testing.T) {
+	for _, tt := range []struct {
+		desc    string
+		typ     E_NetworkDevice_InterfaceType
+		mtu     uint16
+		wantErr bool
+	}{
+		{"ethernet 9000", NetworkDevice_InterfaceType_ethernet, 9000, false},
+		{"ethernet 9216", NetworkDevice_InterfaceType_ethernet, 9216, false},
+		{"ethernet 9217", NetworkDevice_InterfaceType_ethernet, 9217, true},
+		{"wifi 9000", NetworkDevice_InterfaceType_wifi, 9000, true},
+		{"wifi 2304", NetworkDevice_InterfaceType_wifi, 2304, false},
+		{"no type 9000", NetworkDevice_InterfaceType_UNSET, 9000, false},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			d := augmentDevice()
+			d.Interface.InterfaceType = tt.typ
+			d.Interface.Mtu = ygot.Uint16(tt.mtu)
+			if err := ValidateConstraints(d); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateConstraints() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSpeedAutoNegotiate(t *testing.T) {
+	for _, tt := range []struct {
+		desc    string
+		auto    *bool
+		speed   E_NetworkDevice_Interface_Speed
+		wantErr bool
+	}{
+		{"auto on with speed", ygot.Bool(true), NetworkDevice_Interface_Speed_1G, true},
+		{"auto off with speed", ygot.Bool(false), NetworkDevice_Interface_Speed_1G, false},
+		{"auto unset with speed", nil, NetworkDevice_Interface_Speed_10G, false},
+		{"auto on without speed", ygot.Bool(true), NetworkDevice_Interface_Speed_UNSET, false},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			d := augmentDevice()
+			d.Interface.AutoNegotiate = tt.auto
+			d.Interface.Speed = tt.speed
+			if err := ValidateConstraints(d); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateConstraints() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateVerbose(t *testing.T) {
+	// Example 2 of the validate program.
+	d := &Device{}
+	d.GetOrCreateInterface().Priority = ygot.Uint8(25)
+
+	var log strings.Builder
+	if err := ValidateVerbose(d, &log); !errors.Is(err, ErrValidation) {
+		t.Errorf("ValidateVerbose(priority 25) error = %v, want ErrValidation", err)
+	}
+	if !strings.Contains(log.String(), "FAIL /interface/priority: ") {
+		t.Errorf("ValidateVerbose(priority 25) log = %q, want a failed priority check", log.String())
+	}
+
+	log.Reset()
+	if err := ValidateVerbose(augmentDevice(), &log); err != nil {
+		t.Errorf("ValidateVerbose(augment device) error = %v", err)
+	}
+	for _, line := range []string{"ok   /interface/mtu", "ok   /interface/priority", "ok   /interface/status", "ok   constraints"} {
+		if !strings.Contains(log.String(), line+"\n") {
+			t.Errorf("ValidateVerbose(augment device) log = %q, want line %q", log.String(), line)
+		}
+	}
+	if strings.Contains(log.String(), "FAIL") {
+		t.Errorf("ValidateVerbose(augment device) log = %q, want no failures", log.String())
+	}
+}
+
+func TestLeafListUnique(t *testing.T) {
+	tests := []struct {
+		desc    string
+		addrs   []string
+		servers []string
+		wantErr string
+	}{
+		{desc: "unique", addrs: []string{"10.0.0.1", "10.0.0.2"}, servers: []string{"ns1", "ns2"}},
+		{desc: "duplicate address", addrs: []string{"10.0.0.1", "10.0.0.2", "10.0.0.1"}, wantErr: "/interface/ipv4-address: duplicate value 10.0.0.1"},
+		{desc: "duplicate server", servers: []string{"ns1", "ns1"}, wantErr: "/system/dns-server: duplicate value ns1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			d := augmentDevice()
+			d.Interface.Ipv4Address = tt.addrs
+			d.GetOrCreateSystem().DnsServer = tt.servers
+
+			err := ValidateDevice(d)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateDevice() error = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrValidation) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateDevice() error = %v, want ErrValidation with %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMissingMandatory(t *testing.T) {
+	for _, tt := range []struct {
+		desc string
+		d    *Device
+		want []string
+	}{
+		{"empty device", &Device{}, []string{"/interface/name"}},
+		{"no name", &Device{Interface: &NetworkDevice_Interface{Mtu: ygot.Uint16(1500)}}, []string{"/interface/name"}},
+		{"name", augmentDevice(), nil},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			if got := MissingMandatory(tt.d); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MissingMandatory() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
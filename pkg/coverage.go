@@ -0,0 +1,33 @@
+package network
+
+import (
+	"regexp"
+
+	"github.com/openconfig/goyang/pkg/yang"
+)
+
+// listKeys matches the list keys of a path returned by Walk, e.g. [name=eth0].
+var listKeys = regexp.MustCompile(`\[[^]]*\]`)
+
+// Coverage returns the fraction of the leaves and leaf-lists of the schema
+// that are populated in d, from 0 for an empty device to 1 for a device that
+// sets every one of them. A leaf of a list counts once, however many members
+// set it. It is meant to check how much of the model test fixtures touch.
+func Coverage(d *Device) float64 {
+	var total int
+	walkSchema(SchemaTree["Device"], "", func(_ string, e *yang.Entry) {
+		if e.IsLeaf() || e.IsLeafList() {
+			total++
+		}
+	})
+	if total == 0 {
+		return 0
+	}
+
+	set := map[string]bool{}
+	Walk(d, func(path string, _ interface{}) error {
+		set[listKeys.ReplaceAllString(path, "")] = true
+		return nil
+	})
+	return float64(len(set)) / float64(total)
+}
@@ -0,0 +1,34 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/openconfig/goyang/pkg/yang"
+)
+
+func TestCoverage(t *testing.T) {
+	var total int
+	walkSchema(SchemaTree["Device"], "", func(_ string, e *yang.Entry) {
+		if e.IsLeaf() || e.IsLeafList() {
+			total++
+		}
+	})
+
+	if got := Coverage(&Device{}); got != 0 {
+		t.Errorf("Coverage(empty device) = %v, want 0", got)
+	}
+	if got := Coverage(nil); got != 0 {
+		t.Errorf("Coverage(nil) = %v, want 0", got)
+	}
+
+	// augmentDevice sets name, mtu, priority, status and bandwidth.
+	if got, want := Coverage(augmentDevice()), 5/float64(total); got != want {
+		t.Errorf("Coverage(augment device) = %v, want %v", got, want)
+	}
+
+	d := augmentDevice()
+	d.System = &NetworkDevice_System{NtpServer: []string{"10.0.0.1"}}
+	if got, base := Coverage(d), Coverage(augmentDevice()); got <= base {
+		t.Errorf("Coverage(augment device with ntp-server) = %v, want more than %v", got, base)
+	}
+}
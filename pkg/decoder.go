@@ -0,0 +1,27 @@
+package network
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// Decoder reads a stream of RFC7951 JSON documents, each holding a device.
+type Decoder struct {
+	dec *json.Decoder
+}
+
+// NewDecoder returns a Decoder that reads from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{dec: json.NewDecoder(r)}
+}
+
+// Decode reads the next JSON document and unmarshals it into d with
+// ParseDevice, which validates the result, including ValidateConstraints. It
+// returns io.EOF when there are no more documents.
+func (dec *Decoder) Decode(d *Device) error {
+	var doc json.RawMessage
+	if err := dec.dec.Decode(&doc); err != nil {
+		return err
+	}
+	return ParseDevice(doc, d)
+}
@@ -0,0 +1,31 @@
+package network
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestDecoder(t *testing.T) {
+	dec := NewDecoder(strings.NewReader(`
+{"interface": {"name": "eth0", "mtu": 1500}}
+{"interface": {"name": "eth1", "priority": 7}}
+`))
+
+	var d Device
+	if err := dec.Decode(&d); err != nil {
+		t.Fatalf("Decode(valid) error = %v", err)
+	}
+	if d.GetInterface().GetName() != "eth0" || d.GetInterface().GetMtu() != 1500 {
+		t.Errorf("Decode(valid) = %q, %d, want eth0, 1500", d.GetInterface().GetName(), d.GetInterface().GetMtu())
+	}
+
+	if err := dec.Decode(&Device{}); !errors.Is(err, ErrValidation) {
+		t.Errorf("Decode(priority 7) error = %v, want ErrValidation", err)
+	}
+
+	if err := dec.Decode(&Device{}); err != io.EOF {
+		t.Errorf("Decode() at the end error = %v, want io.EOF", err)
+	}
+}
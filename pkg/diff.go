@@ -0,0 +1,270 @@
+package network
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/value"
+	"github.com/openconfig/ygot/ygot"
+)
+
+// DiffText returns the differences between two devices as human-readable
+// lines, one per leaf, sorted by path. Changed leaves are prefixed with "~",
+// added leaves with "+" and deleted leaves with "-":
+//
+//	~ /interface/mtu: 1500 -> 9000
+//	+ /interface/priority: 12
+//	- /interface/name
+func DiffText(a, b *Device) ([]string, error) {
+	n, err := ygot.Diff(a, b)
+	if err != nil {
+		return nil, fmt.Errorf("cannot diff devices: %w", err)
+	}
+
+	old, err := leafValues(a)
+	if err != nil {
+		return nil, err
+	}
+
+	type line struct{ path, text string }
+	var lines []line
+	for _, u := range n.GetUpdate() {
+		p, err := ygot.PathToString(u.GetPath())
+		if err != nil {
+			return nil, err
+		}
+		if prev, ok := old[p]; ok {
+			lines = append(lines, line{p, fmt.Sprintf("~ %s: %s -> %s", p, valueString(prev), valueString(u.GetVal()))})
+			continue
+		}
+		lines = append(lines, line{p, fmt.Sprintf("+ %s: %s", p, valueString(u.GetVal()))})
+	}
+	for _, d := range n.GetDelete() {
+		p, err := ygot.PathToString(d)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, line{p, "- " + p})
+	}
+
+	sort.SliceStable(lines, func(i, j int) bool { return lines[i].path < lines[j].path })
+	out := make([]string, 0, len(lines))
+	for _, l := range lines {
+		out = append(out, l.text)
+	}
+	return out, nil
+}
+
+// DiffOpt is an option that modifies the behaviour of DiffWith.
+type DiffOpt func(*diffConfig)
+
+type diffConfig struct {
+	exclude     [][]string
+	comparators []leafComparator
+}
+
+type leafComparator struct {
+	pattern []string
+	eq      func(a, b interface{}) bool
+}
+
+// Excluding makes DiffWith leave out the updates and deletes of the leaves
+// whose path matches any of patterns, as DiffExcluding does.
+func Excluding(patterns ...string) DiffOpt {
+	return func(c *diffConfig) {
+		for _, p := range patterns {
+			c.exclude = append(c.exclude, splitPattern(p))
+		}
+	}
+}
+
+// WithLeafComparator makes DiffWith compare the leaves matching path, a
+// pattern like those of DiffExcluding, with eq instead of by value. A change for which
+// eq returns true is left out. eq is given the scalar values of the leaf in
+// each device, as returned by value.ToScalar, or nil where it is unset. It
+// lets counters that only grew count as unchanged, for instance.
+func WithLeafComparator(path string, eq func(a, b interface{}) bool) DiffOpt {
+	return func(c *diffConfig) {
+		c.comparators = append(c.comparators, leafComparator{splitPattern(path), eq})
+	}
+}
+
+// DiffExcluding returns the differences between two devices, like ygot.Diff,
+// leaving out the updates and deletes of the leaves whose path matches any of
+// the exclude patterns. A pattern is a schema path, without list keys, in
+// which * matches any single element and a final ** any number of them, e.g.
+// /interface/state/** for every state leaf of the interface.
+func DiffExcluding(a, b *Device, exclude ...string) (*gnmi.Notification, error) {
+	return DiffWith(a, b, Excluding(exclude...))
+}
+
+// DiffWith returns the differences between two devices, like ygot.Diff,
+// leaving out the changes that opts exclude or that their comparators find
+// equal.
+func DiffWith(a, b *Device, opts ...DiffOpt) (*gnmi.Notification, error) {
+	cfg := &diffConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	n, err := ygot.Diff(a, b)
+	if err != nil {
+		return nil, fmt.Errorf("cannot diff devices: %w", err)
+	}
+	var old map[string]*gnmi.TypedValue
+	if len(cfg.comparators) > 0 {
+		if old, err = leafValues(a); err != nil {
+			return nil, err
+		}
+	}
+
+	// keep reports whether the change of the leaf at p to val, nil for a
+	// delete, is neither excluded nor equal by a comparator.
+	keep := func(p *gnmi.Path, val *gnmi.TypedValue) (bool, error) {
+		s, err := ygot.PathToString(p)
+		if err != nil {
+			return false, err
+		}
+		elems := schemaElems(s)
+		for _, pat := range cfg.exclude {
+			if matchElems(pat, elems) {
+				return false, nil
+			}
+		}
+		for _, c := range cfg.comparators {
+			if matchElems(c.pattern, elems) && c.eq(scalar(old[s]), scalar(val)) {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+
+	out := &gnmi.Notification{Timestamp: n.GetTimestamp(), Prefix: n.GetPrefix()}
+	for _, u := range n.GetUpdate() {
+		ok, err := keep(u.GetPath(), u.GetVal())
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			out.Update = append(out.Update, u)
+		}
+	}
+	for _, d := range n.GetDelete() {
+		ok, err := keep(d, nil)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			out.Delete = append(out.Delete, d)
+		}
+	}
+	return out, nil
+}
+
+// UnmarshalDelta unmarshals data, like ParseDevice, onto a copy of base, and
+// returns the result along with the sorted paths of the leaves whose value it
+// changed. Leaves that data sets to the value they already have in base are
+// not reported. base is not modified, and a nil base is an empty device.
+func UnmarshalDelta(base *Device, data []byte) (changed []string, result *Device, err error) {
+	if base == nil {
+		base = &Device{}
+	}
+	cp, err := ygot.DeepCopy(base)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot copy device: %w", err)
+	}
+	result = cp.(*Device)
+	if err := ParseDevice(data, result); err != nil {
+		return nil, nil, err
+	}
+
+	n, err := ygot.Diff(base, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot diff devices: %w", err)
+	}
+	for _, u := range n.GetUpdate() {
+		p, err := ygot.PathToString(u.GetPath())
+		if err != nil {
+			return nil, nil, err
+		}
+		changed = append(changed, p)
+	}
+	sort.Strings(changed)
+	return changed, result, nil
+}
+
+// HasDrifted reports whether actual differs from intended in any leaf that is
+// not excluded by the ignore patterns, which are those of DiffExcluding. A
+// failure to diff the devices counts as drift, so that it is not mistaken for
+// compliance.
+func HasDrifted(intended, actual *Device, ignore ...string) bool {
+	n, err := DiffExcluding(intended, actual, ignore...)
+	return err != nil || len(n.GetUpdate()) > 0 || len(n.GetDelete()) > 0
+}
+
+// leafValues returns the values of the leaves set in d, keyed by path.
+// Diffing against an empty device yields every one of them.
+func leafValues(d *Device) (map[string]*gnmi.TypedValue, error) {
+	n, err := ygot.Diff(&Device{}, d)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read original values: %w", err)
+	}
+	out := make(map[string]*gnmi.TypedValue, len(n.GetUpdate()))
+	for _, u := range n.GetUpdate() {
+		p, err := ygot.PathToString(u.GetPath())
+		if err != nil {
+			return nil, err
+		}
+		out[p] = u.GetVal()
+	}
+	return out, nil
+}
+
+// splitPattern splits a DiffExcluding pattern into its elements.
+func splitPattern(pattern string) []string {
+	return strings.Split(strings.TrimPrefix(pattern, "/"), "/")
+}
+
+// schemaElems splits the data path p into its elements, without list keys,
+// for matchElems.
+func schemaElems(p string) []string {
+	return strings.Split(strings.TrimPrefix(listKeys.ReplaceAllString(p, ""), "/"), "/")
+}
+
+// matchElems reports whether the path elements elems match the pattern
+// elements pat.
+func matchElems(pat, elems []string) bool {
+	for i, p := range pat {
+		if p == "**" && i == len(pat)-1 {
+			return true
+		}
+		if i >= len(elems) || (p != "*" && p != elems[i]) {
+			return false
+		}
+	}
+	return len(pat) == len(elems)
+}
+
+// scalar returns the scalar value of tv, or nil if tv is nil or cannot be
+// converted.
+func scalar(tv *gnmi.TypedValue) interface{} {
+	if tv == nil {
+		return nil
+	}
+	v, err := value.ToScalar(tv)
+	if err != nil {
+		return nil
+	}
+	return v
+}
+
+// valueString renders a gNMI TypedValue as its scalar value.
+func valueString(tv *gnmi.TypedValue) string {
+	v, err := value.ToScalar(tv)
+	if err != nil {
+		return tv.String()
+	}
+	return fmt.Sprint(v)
+}
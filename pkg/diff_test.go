@@ -0,0 +1,252 @@
+package network
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+func TestDiffText(t *testing.T) {
+	a := &Device{}
+	ia := a.GetOrCreateInterface()
+	ia.Name = ygot.String("eth0")
+	ia.Mtu = ygot.Uint16(1500)
+
+	b := &Device{}
+	b.GetOrCreateInterface().Mtu = ygot.Uint16(9000)
+	b.GetOrCreateInterface().Priority = ygot.Uint8(12)
+
+	got, err := DiffText(a, b)
+	if err != nil {
+		t.Fatalf("DiffText() error = %v", err)
+	}
+	want := []string{
+		"~ /interface/mtu: 1500 -> 9000",
+		"- /interface/name",
+		"+ /interface/priority: 12",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffText() = %q, want %q", got, want)
+	}
+}
+
+func TestDiffTextEqual(t *testing.T) {
+	d := &Device{}
+	d.GetOrCreateInterface().Name = ygot.String("eth0")
+
+	got, err := DiffText(d, d)
+	if err != nil {
+		t.Fatalf("DiffText() error = %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("DiffText() = %q, want no differences", got)
+	}
+}
+
+func TestDiffExcluding(t *testing.T) {
+	a := augmentDevice()
+	a.Interface.GetOrCreateState().GetOrCreateCounters().InOctets = ygot.Uint64(100)
+	a.Interface.GetOrCreateState().GetOrCreateCounters().OutOctets = ygot.Uint64(100)
+	a.Interface.Description = ygot.String("uplink")
+
+	b := augmentDevice()
+	b.Interface.Mtu = ygot.Uint16(9000)
+	b.Interface.GetOrCreateState().GetOrCreateCounters().InOctets = ygot.Uint64(200)
+
+	tests := []struct {
+		desc        string
+		exclude     []string
+		wantUpdates []string
+		wantDeletes []string
+	}{
+		{
+			desc:        "nothing excluded",
+			wantUpdates: []string{"/interface/mtu", "/interface/state/counters/in-octets"},
+			wantDeletes: []string{"/interface/description", "/interface/state/counters/out-octets"},
+		},
+		{
+			desc:        "state subtree",
+			exclude:     []string{"/interface/state/**"},
+			wantUpdates: []string{"/interface/mtu"},
+			wantDeletes: []string{"/interface/description"},
+		},
+		{
+			desc:        "single element wildcard",
+			exclude:     []string{"/interface/*"},
+			wantUpdates: []string{"/interface/state/counters/in-octets"},
+			wantDeletes: []string{"/interface/state/counters/out-octets"},
+		},
+		{
+			desc:        "exact leaves",
+			exclude:     []string{"/interface/mtu", "/interface/state/counters/in-octets", "/interface/state"},
+			wantDeletes: []string{"/interface/description", "/interface/state/counters/out-octets"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			n, err := DiffExcluding(a, b, tt.exclude...)
+			if err != nil {
+				t.Fatalf("DiffExcluding() error = %v", err)
+			}
+			var updates, deletes []string
+			for _, u := range n.GetUpdate() {
+				p, err := ygot.PathToString(u.GetPath())
+				if err != nil {
+					t.Fatalf("PathToString() error = %v", err)
+				}
+				updates = append(updates, p)
+			}
+			for _, d := range n.GetDelete() {
+				p, err := ygot.PathToString(d)
+				if err != nil {
+					t.Fatalf("PathToString() error = %v", err)
+				}
+				deletes = append(deletes, p)
+			}
+			sort.Strings(updates)
+			sort.Strings(deletes)
+			if !reflect.DeepEqual(updates, tt.wantUpdates) || !reflect.DeepEqual(deletes, tt.wantDeletes) {
+				t.Errorf("DiffExcluding(%q) = updates %q, deletes %q, want %q, %q", tt.exclude, updates, deletes, tt.wantUpdates, tt.wantDeletes)
+			}
+		})
+	}
+}
+
+func TestDiffWithLeafComparator(t *testing.T) {
+	a := augmentDevice()
+	a.Interface.GetOrCreateState().GetOrCreateCounters().InOctets = ygot.Uint64(100)
+	a.Interface.GetOrCreateState().GetOrCreateCounters().OutOctets = ygot.Uint64(100)
+
+	counters := augmentDevice()
+	counters.Interface.GetOrCreateState().GetOrCreateCounters().InOctets = ygot.Uint64(150)
+	counters.Interface.GetOrCreateState().GetOrCreateCounters().OutOctets = ygot.Uint64(120)
+
+	mtu := augmentDevice()
+	mtu.Interface.Mtu = ygot.Uint16(9000)
+	mtu.Interface.GetOrCreateState().GetOrCreateCounters().InOctets = ygot.Uint64(150)
+	mtu.Interface.GetOrCreateState().GetOrCreateCounters().OutOctets = ygot.Uint64(100)
+
+	// Counters that grew are not drift.
+	grew := WithLeafComparator("/interface/state/counters/*", func(a, b interface{}) bool {
+		before, ok1 := a.(uint64)
+		after, ok2 := b.(uint64)
+		return ok1 && ok2 && after >= before
+	})
+
+	n, err := DiffWith(a, counters, grew)
+	if err != nil {
+		t.Fatalf("DiffWith(counters) error = %v", err)
+	}
+	if len(n.GetUpdate()) != 0 || len(n.GetDelete()) != 0 {
+		t.Errorf("DiffWith(counters) = %v, want no differences", n)
+	}
+
+	n, err = DiffWith(a, mtu, grew)
+	if err != nil {
+		t.Fatalf("DiffWith(mtu) error = %v", err)
+	}
+	if len(n.GetUpdate()) != 1 || len(n.GetDelete()) != 0 {
+		t.Fatalf("DiffWith(mtu) = %v, want the mtu update alone", n)
+	}
+	if p, _ := ygot.PathToString(n.GetUpdate()[0].GetPath()); p != "/interface/mtu" {
+		t.Errorf("DiffWith(mtu) updates %s, want /interface/mtu", p)
+	}
+
+	n, err = DiffWith(a, mtu, grew, Excluding("/interface/mtu"))
+	if err != nil {
+		t.Fatalf("DiffWith(mtu, Excluding) error = %v", err)
+	}
+	if len(n.GetUpdate()) != 0 || len(n.GetDelete()) != 0 {
+		t.Errorf("DiffWith(mtu, Excluding) = %v, want no differences", n)
+	}
+
+	// A counter that went down, or without the comparator one that grew, is
+	// reported.
+	reset := augmentDevice()
+	reset.Interface.GetOrCreateState().GetOrCreateCounters().InOctets = ygot.Uint64(10)
+	reset.Interface.GetOrCreateState().GetOrCreateCounters().OutOctets = ygot.Uint64(100)
+	for _, tt := range []struct {
+		desc string
+		b    *Device
+		opts []DiffOpt
+	}{
+		{"reset counter", reset, []DiffOpt{grew}},
+		{"no comparator", counters, nil},
+	} {
+		n, err := DiffWith(a, tt.b, tt.opts...)
+		if err != nil {
+			t.Fatalf("DiffWith(%s) error = %v", tt.desc, err)
+		}
+		if len(n.GetUpdate()) == 0 {
+			t.Errorf("DiffWith(%s) = %v, want counter updates", tt.desc, n)
+		}
+	}
+}
+
+func TestHasDrifted(t *testing.T) {
+	withMtu := augmentDevice()
+	withMtu.Interface.Mtu = ygot.Uint16(9000)
+	withState := augmentDevice()
+	withState.Interface.GetOrCreateState().GetOrCreateCounters().InOctets = ygot.Uint64(100)
+
+	tests := []struct {
+		desc   string
+		actual *Device
+		ignore []string
+		want   bool
+	}{
+		{desc: "identical", actual: augmentDevice()},
+		{desc: "mtu", actual: withMtu, ignore: []string{"/interface/state/**"}, want: true},
+		{desc: "state", actual: withState, want: true},
+		{desc: "ignored state", actual: withState, ignore: []string{"/interface/state/**"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			if got := HasDrifted(augmentDevice(), tt.actual, tt.ignore...); got != tt.want {
+				t.Errorf("HasDrifted(%q) = %v, want %v", tt.ignore, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalDelta(t *testing.T) {
+	base := augmentDevice()
+	in := []byte(`{"interface": {"name": "eth0", "mtu": 9000, "description": "uplink"}}`)
+
+	changed, result, err := UnmarshalDelta(base, in)
+	if err != nil {
+		t.Fatalf("UnmarshalDelta() error = %v", err)
+	}
+	if want := []string{"/interface/description", "/interface/mtu"}; !reflect.DeepEqual(changed, want) {
+		t.Errorf("UnmarshalDelta() changed = %q, want %q", changed, want)
+	}
+	if got := result.GetInterface().GetMtu(); got != 9000 {
+		t.Errorf("UnmarshalDelta() mtu = %d, want 9000", got)
+	}
+	if got := result.GetInterface().GetPriority(); got != 12 {
+		t.Errorf("UnmarshalDelta() priority = %d, want 12 from base", got)
+	}
+	if got := base.GetInterface().GetMtu(); got != 1500 {
+		t.Errorf("UnmarshalDelta() changed the mtu of base to %d", got)
+	}
+
+	changed, _, err = UnmarshalDelta(base, []byte(`{"interface": {"name": "eth0"}}`))
+	if err != nil {
+		t.Fatalf("UnmarshalDelta(same name) error = %v", err)
+	}
+	if len(changed) != 0 {
+		t.Errorf("UnmarshalDelta(same name) changed = %q, want none", changed)
+	}
+
+	changed, _, err = UnmarshalDelta(nil, []byte(`{"interface": {"name": "eth0"}}`))
+	if err != nil || !reflect.DeepEqual(changed, []string{"/interface/name"}) {
+		t.Errorf("UnmarshalDelta(nil) = %q, %v, want [/interface/name]", changed, err)
+	}
+
+	if _, _, err := UnmarshalDelta(base, []byte(`{"interface": {"priority": 7}}`)); !errors.Is(err, ErrValidation) {
+		t.Errorf("UnmarshalDelta(priority 7) error = %v, want ErrValidation", err)
+	}
+}
@@ -0,0 +1,50 @@
+package network
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/openconfig/goyang/pkg/yang"
+)
+
+// ExportDOT renders the schema tree, as augmented and deviated, as a Graphviz
+// graph: containers and lists are boxes, and leaves and leaf-lists ellipses
+// labeled with their name and type. Nodes are identified by their path, and
+// choice and case statements are left out, as in data paths. Render it with,
+// e.g., dot -Tsvg.
+func ExportDOT() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString("digraph network_device {\n")
+	b.WriteString("  \"/\" [label=\"device\", shape=box];\n")
+	walkSchema(SchemaTree["Device"], "", func(path string, e *yang.Entry) {
+		label, shape := e.Name, "box"
+		if !e.IsDir() {
+			label, shape = e.Name+"\n"+dotType(e.Type), "ellipse"
+		}
+		if e.IsLeafList() {
+			label += "[]"
+		}
+		fmt.Fprintf(&b, "  %q [label=%s, shape=%s];\n", path, strconv.Quote(label), shape)
+		parent := path[:strings.LastIndex(path, "/")]
+		if parent == "" {
+			parent = "/"
+		}
+		fmt.Fprintf(&b, "  %q -> %q;\n", parent, path)
+	})
+	b.WriteString("}\n")
+	return b.Bytes(), nil
+}
+
+// dotType names the type t, followed by its built-in kind when t is a
+// typedef, e.g. "mtu-size (uint16)".
+func dotType(t *yang.YangType) string {
+	if t == nil {
+		return ""
+	}
+	if t.Name != t.Kind.String() {
+		return fmt.Sprintf("%s (%s)", t.Name, t.Kind)
+	}
+	return t.Name
+}
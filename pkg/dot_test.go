@@ -0,0 +1,34 @@
+package network
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExportDOT(t *testing.T) {
+	out, err := ExportDOT()
+	if err != nil {
+		t.Fatalf("ExportDOT() error = %v", err)
+	}
+	dot := string(out)
+	if !strings.HasPrefix(dot, "digraph ") || !strings.HasSuffix(dot, "}\n") {
+		t.Errorf("ExportDOT() = %s, want a digraph", dot)
+	}
+	for _, want := range []string{
+		`"/interface" [label="interface", shape=box];`,
+		`"/interface/mtu" [label="mtu\nmtu-size (uint16)", shape=ellipse];`,
+		`"/interface/status" [label="status\nunion", shape=ellipse];`,
+		`"/interface/bandwidth" [label="bandwidth\nbandwidth-mbps (uint32)", shape=ellipse];`,
+		`"/" -> "/interface";`,
+		`"/interface" -> "/interface/mtu";`,
+		// ipv4-address is within the address-mode choice.
+		`"/interface" -> "/interface/ipv4-address";`,
+	} {
+		if !strings.Contains(dot, want) {
+			t.Errorf("ExportDOT() = %s, want it to contain %s", dot, want)
+		}
+	}
+	if strings.Contains(dot, "address-mode") {
+		t.Errorf("ExportDOT() = %s, want no choice nodes", dot)
+	}
+}
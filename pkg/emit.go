@@ -0,0 +1,420 @@
+package network
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/util"
+	"github.com/openconfig/ygot/ygot"
+)
+
+// indentString is the indentation used by ygot.EmitJSON by default.
+const indentString = "   "
+
+// EmitJSONTo writes s to w as JSON, formatted like ygot.EmitJSON does, and
+// followed by a newline. Rather than building the whole document first, it
+// encodes the leaves of one container or list member at a time and writes
+// them out before moving on to the next, so memory use does not grow with the
+// size of s. Containers without any populated leaf are left out, unless they
+// are presence containers. A nil opts emits RFC7951 JSON.
+func EmitJSONTo(w io.Writer, s ygot.GoStruct, opts *ygot.EmitJSONConfig) error {
+	if opts == nil {
+		opts = &ygot.EmitJSONConfig{Format: ygot.RFC7951}
+	}
+	if !opts.SkipValidation {
+		if err := ygot.ValidateGoStruct(s, opts.ValidationOpts...); err != nil {
+			return fmt.Errorf("%w: %w", ErrValidation, err)
+		}
+	}
+
+	e := &jsonEmitter{w: bufio.NewWriter(w), opts: opts, indent: indentString}
+	if opts.Indent != "" {
+		e.indent = opts.Indent
+	}
+	var mod string
+	if vs, ok := s.(ygot.ValidatedGoStruct); ok {
+		mod = vs.ΛBelongingModule()
+	}
+	if err := e.container(reflect.ValueOf(s), mod, ""); err != nil {
+		return err
+	}
+	e.w.WriteByte('\n')
+	return e.w.Flush()
+}
+
+// jsonEmitter writes a GoStruct as indented JSON, one node at a time.
+type jsonEmitter struct {
+	w      *bufio.Writer
+	opts   *ygot.EmitJSONConfig
+	indent string
+}
+
+// jsonMember is a member of a JSON object written by jsonEmitter: either a
+// leaf, with its JSON value, or a container or list, with the module that
+// defines it, to descend into.
+type jsonMember struct {
+	name   string
+	value  interface{}
+	node   reflect.Value
+	module string
+}
+
+// container writes the GoStruct pointer v, defined by module mod, as a JSON
+// object whose closing line is indented by prefix.
+func (e *jsonEmitter) container(v reflect.Value, mod, prefix string) error {
+	members, err := e.members(v, mod)
+	if err != nil {
+		return err
+	}
+	if len(members) == 0 {
+		e.w.WriteString("{}")
+		return nil
+	}
+
+	inner := prefix + e.indent
+	e.w.WriteString("{\n")
+	for i, m := range members {
+		name, _ := json.Marshal(m.name)
+		fmt.Fprintf(e.w, "%s%s: ", inner, name)
+		switch {
+		case !m.node.IsValid():
+			err = e.value(m.value, inner)
+		case m.node.Kind() == reflect.Map:
+			err = e.list(m.node, m.module, inner)
+		default:
+			err = e.container(m.node, m.module, inner)
+		}
+		if err != nil {
+			return err
+		}
+		if i < len(members)-1 {
+			e.w.WriteByte(',')
+		}
+		e.w.WriteByte('\n')
+	}
+	e.w.WriteString(prefix + "}")
+	return nil
+}
+
+// list writes the members of the keyed list m, defined by module mod, in key
+// order: as a JSON array in RFC7951 format, or as an object keyed by the list
+// keys in the internal format.
+func (e *jsonEmitter) list(m reflect.Value, mod, prefix string) error {
+	byKey := make(map[string]reflect.Value, m.Len())
+	keys := make([]string, 0, m.Len())
+	for _, k := range m.MapKeys() {
+		ks := fmt.Sprint(k.Interface())
+		byKey[ks] = m.MapIndex(k)
+		keys = append(keys, ks)
+	}
+	sort.Strings(keys)
+
+	rfc7951 := e.opts.Format == ygot.RFC7951
+	start, end := "{", "}"
+	if rfc7951 {
+		start, end = "[", "]"
+	}
+	inner := prefix + e.indent
+	e.w.WriteString(start + "\n")
+	for i, k := range keys {
+		e.w.WriteString(inner)
+		if !rfc7951 {
+			name, _ := json.Marshal(k)
+			fmt.Fprintf(e.w, "%s: ", name)
+		}
+		if err := e.container(byKey[k], mod, inner); err != nil {
+			return err
+		}
+		if i < len(keys)-1 {
+			e.w.WriteByte(',')
+		}
+		e.w.WriteByte('\n')
+	}
+	e.w.WriteString(prefix + end)
+	return nil
+}
+
+// members returns the members of the JSON object for the GoStruct pointer v,
+// defined by module mod, sorted by name like encoding/json sorts map keys. The
+// leaves are encoded by ygot from a copy of v that holds no containers or
+// lists, so that only this level of the tree is built.
+func (e *jsonEmitter) members(v reflect.Value, mod string) ([]jsonMember, error) {
+	sv := v.Elem()
+	leaves := reflect.New(sv.Type())
+	var members []jsonMember
+	for i := 0; i < sv.NumField(); i++ {
+		sf := sv.Type().Field(i)
+		tag, ok := sf.Tag.Lookup("path")
+		f := sv.Field(i)
+		if !ok || util.IsValueNil(f.Interface()) {
+			continue
+		}
+		module := sf.Tag.Get("module")
+		switch {
+		case f.Kind() == reflect.Map:
+			if f.Len() > 0 {
+				members = append(members, jsonMember{name: e.nodeName(tag, module, mod), node: f, module: module})
+			}
+		case f.Kind() == reflect.Ptr && f.Elem().Kind() == reflect.Struct:
+			if populated(f.Elem(), false) || isPresence(sf) {
+				members = append(members, jsonMember{name: e.nodeName(tag, module, mod), node: f, module: module})
+			}
+		default:
+			leaves.Elem().Field(i).Set(f)
+		}
+	}
+
+	var (
+		tree map[string]interface{}
+		err  error
+	)
+	gs := leaves.Interface().(ygot.GoStruct)
+	if e.opts.Format == ygot.RFC7951 {
+		tree, err = ygot.ConstructIETFJSON(gs, e.opts.RFC7951Config)
+	} else {
+		tree, err = ygot.ConstructInternalJSON(gs)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot build JSON for %s: %w", sv.Type().Name(), err)
+	}
+	for name, val := range tree {
+		// Leaves are only qualified when their module differs from the
+		// one of their parent.
+		if m, n, ok := strings.Cut(name, ":"); ok && m == mod {
+			name = n
+		}
+		members = append(members, jsonMember{name: name, value: val})
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].name < members[j].name })
+	return members, nil
+}
+
+// nodeName returns the JSON member name of the container or list with path
+// tag, defined by module, whose parent is defined by parent. In RFC7951 format
+// with module names, it is qualified when the module changes.
+func (e *jsonEmitter) nodeName(tag, module, parent string) string {
+	cfg := e.opts.RFC7951Config
+	if e.opts.Format == ygot.RFC7951 && cfg != nil && cfg.AppendModuleName && module != parent {
+		return module + ":" + tag
+	}
+	return tag
+}
+
+// value writes the JSON encoding of a leaf value, indenting any line after
+// the first by prefix.
+func (e *jsonEmitter) value(v interface{}, prefix string) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(e.opts.EscapeHTML)
+	enc.SetIndent(prefix, e.indent)
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf("cannot encode %v: %w", v, err)
+	}
+	e.w.Write(bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+	return nil
+}
+
+// MarshalOpt is an option that modifies the behaviour of MarshalJSON.
+type MarshalOpt func(*marshalConfig)
+
+type marshalConfig struct {
+	enumAsInt bool
+}
+
+// EnumAsInt makes MarshalJSON emit enumerated leaves as their integer value,
+// as assigned by the generated code, instead of their YANG name. It is meant
+// for consumers that cannot handle names, since RFC7951 does not allow it.
+func EnumAsInt() MarshalOpt {
+	return func(c *marshalConfig) {
+		c.enumAsInt = true
+	}
+}
+
+// MarshalJSON validates d with ValidateDevice and returns it as RFC7951 JSON,
+// indented like EmitFields does.
+func MarshalJSON(d *Device, opts ...MarshalOpt) ([]byte, error) {
+	var cfg marshalConfig
+	for _, o := range opts {
+		o(&cfg)
+	}
+	if err := ValidateDevice(d); err != nil {
+		return nil, err
+	}
+	tree, err := ygot.ConstructIETFJSON(d, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot build RFC7951 tree: %w", err)
+	}
+	if cfg.enumAsInt {
+		err := Walk(d, func(path string, value interface{}) error {
+			if _, ok := value.(ygot.GoEnum); !ok {
+				return nil
+			}
+			p, _, err := resolvePath(path)
+			if err != nil {
+				return err
+			}
+			setTree(tree, p.GetElem(), reflect.ValueOf(value).Int())
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return json.MarshalIndent(tree, "", indentString)
+}
+
+// ToPlainJSON returns d as simplified, lossy JSON for consumers that cannot
+// handle RFC7951, such as dashboards: member names are not qualified with
+// their module, empty leaves are true rather than [null], and 64-bit integers
+// and decimals are numbers rather than strings. Enumerations keep their YANG
+// names. d is not validated.
+func ToPlainJSON(d *Device) ([]byte, error) {
+	tree, err := ygot.ConstructIETFJSON(d, &ygot.RFC7951JSONConfig{AppendModuleName: true})
+	if err != nil {
+		return nil, fmt.Errorf("cannot build RFC7951 tree: %w", err)
+	}
+	return json.MarshalIndent(plainTree(SchemaTree["Device"], tree), "", indentString)
+}
+
+// plainTree returns the RFC7951 JSON value v, an instance of the schema entry
+// e, in the form described by ToPlainJSON.
+func plainTree(e *yang.Entry, v interface{}) interface{} {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(x))
+		for k, cv := range x {
+			if _, name, ok := strings.Cut(k, ":"); ok {
+				k = name
+			}
+			if child := childEntry(e, k); child != nil {
+				cv = plainTree(child, cv)
+			}
+			out[k] = cv
+		}
+		return out
+	case []interface{}:
+		if e.Type != nil && e.Type.Kind == yang.Yempty && !e.IsLeafList() {
+			return true
+		}
+		out := make([]interface{}, len(x))
+		for i, m := range x {
+			out[i] = plainTree(e, m)
+		}
+		return out
+	case string:
+		if e.Type == nil {
+			return x
+		}
+		switch e.Type.Kind {
+		case yang.Yint64, yang.Yuint64, yang.Ydecimal64:
+			return json.Number(x)
+		}
+	}
+	return v
+}
+
+// setTree replaces the value found at elems within a JSON tree of nested
+// objects and RFC7951 lists with v. It does nothing if there is no such
+// value.
+func setTree(tree map[string]interface{}, elems []*gnmi.PathElem, v interface{}) {
+	obj := tree
+	for i, e := range elems {
+		if i == len(elems)-1 {
+			if _, ok := obj[e.GetName()]; ok {
+				obj[e.GetName()] = v
+			}
+			return
+		}
+		next, ok := obj[e.GetName()].(map[string]interface{})
+		if members, isList := obj[e.GetName()].([]interface{}); isList {
+			next, ok = listMember(members, e.GetKey())
+		}
+		if !ok {
+			return
+		}
+		obj = next
+	}
+}
+
+// listMember returns the member of an RFC7951 list whose key leaves hold the
+// values in keys.
+func listMember(members []interface{}, keys map[string]string) (map[string]interface{}, bool) {
+	for _, m := range members {
+		obj, ok := m.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		match := true
+		for k, want := range keys {
+			if fmt.Sprint(obj[k]) != want {
+				match = false
+				break
+			}
+		}
+		if match {
+			return obj, true
+		}
+	}
+	return nil, false
+}
+
+// EmitFields returns the RFC7951 JSON of d pruned to the nodes at paths, e.g.
+// /interface/name. Paths that are not populated in d are left out.
+func EmitFields(d *Device, paths ...string) ([]byte, error) {
+	tree, err := ygot.ConstructIETFJSON(d, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot build RFC7951 tree: %w", err)
+	}
+
+	out := map[string]interface{}{}
+	for _, path := range paths {
+		p, _, err := resolvePath(path)
+		if err != nil {
+			return nil, err
+		}
+		elems := p.GetElem()
+		if len(elems) == 0 {
+			// The root selects the whole device.
+			return json.MarshalIndent(tree, "", indentString)
+		}
+		v, ok := lookupTree(tree, elems)
+		if !ok {
+			continue
+		}
+		dst := out
+		for _, e := range elems[:len(elems)-1] {
+			next, ok := dst[e.GetName()].(map[string]interface{})
+			if !ok {
+				next = map[string]interface{}{}
+				dst[e.GetName()] = next
+			}
+			dst = next
+		}
+		dst[elems[len(elems)-1].GetName()] = v
+	}
+	return json.MarshalIndent(out, "", indentString)
+}
+
+// lookupTree returns the value found at elems within a JSON tree of nested
+// objects.
+func lookupTree(tree map[string]interface{}, elems []*gnmi.PathElem) (interface{}, bool) {
+	var v interface{} = tree
+	for _, e := range elems {
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if v, ok = obj[e.GetName()]; !ok {
+			return nil, false
+		}
+	}
+	return v, true
+}
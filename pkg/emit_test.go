@@ -0,0 +1,184 @@
+package network
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+func TestEmitJSONTo(t *testing.T) {
+	for _, tt := range []struct {
+		desc string
+		opts *ygot.EmitJSONConfig
+		// want is the config given to EmitJSON, if different from opts.
+		want *ygot.EmitJSONConfig
+	}{
+		{desc: "default is RFC7951", want: &ygot.EmitJSONConfig{Format: ygot.RFC7951}},
+		{desc: "RFC7951", opts: &ygot.EmitJSONConfig{Format: ygot.RFC7951}},
+		{desc: "RFC7951 with module names", opts: &ygot.EmitJSONConfig{Format: ygot.RFC7951, RFC7951Config: &ygot.RFC7951JSONConfig{AppendModuleName: true}}},
+		{desc: "internal", opts: &ygot.EmitJSONConfig{Format: ygot.Internal}},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			d := augmentDevice()
+			d.Interface.Ipv4Address = []string{"10.0.0.1", "10.0.0.2"}
+			d.Interface.GetOrCreateState().GetOrCreateCounters().InOctets = ygot.Uint64(10)
+			wantOpts := tt.opts
+			if tt.want != nil {
+				wantOpts = tt.want
+			}
+			want, err := ygot.EmitJSON(d, wantOpts)
+			if err != nil {
+				t.Fatalf("EmitJSON() error = %v", err)
+			}
+			var buf bytes.Buffer
+			if err := EmitJSONTo(&buf, d, tt.opts); err != nil {
+				t.Fatalf("EmitJSONTo() error = %v", err)
+			}
+			if got := buf.String(); got != want+"\n" {
+				t.Errorf("EmitJSONTo() = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+// countingWriter counts the calls to Write.
+type countingWriter struct{ writes int }
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.writes++
+	return len(p), nil
+}
+
+func TestEmitJSONToLarge(t *testing.T) {
+	d := augmentDevice()
+	for n := range 10000 {
+		d.Interface.Ipv4Address = append(d.Interface.Ipv4Address, fmt.Sprintf("10.0.%d.%d", n/250, n%250))
+	}
+	if err := EmitJSONTo(io.Discard, d, nil); err != nil {
+		t.Errorf("EmitJSONTo(io.Discard) error = %v", err)
+	}
+	// The output is written out as it is encoded, not in one go at the end.
+	w := &countingWriter{}
+	if err := EmitJSONTo(w, d, nil); err != nil {
+		t.Fatalf("EmitJSONTo() error = %v", err)
+	}
+	if w.writes < 2 {
+		t.Errorf("EmitJSONTo() wrote %d times, want the output written in chunks", w.writes)
+	}
+}
+
+func TestEmitFields(t *testing.T) {
+	for _, tt := range []struct {
+		desc  string
+		paths []string
+		want  map[string]interface{}
+	}{
+		{"name", []string{"/interface/name"}, map[string]interface{}{"name": "eth0"}},
+		{"mtu", []string{"/interface/mtu"}, map[string]interface{}{"mtu": float64(1500)}},
+		{"name and mtu", []string{"/interface/name", "/interface/mtu"}, map[string]interface{}{"name": "eth0", "mtu": float64(1500)}},
+		{"unset leaf", []string{"/interface/description"}, nil},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			out, err := EmitFields(augmentDevice(), tt.paths...)
+			if err != nil {
+				t.Fatalf("EmitFields() error = %v", err)
+			}
+			var got map[string]map[string]interface{}
+			if err := json.Unmarshal(out, &got); err != nil {
+				t.Fatalf("EmitFields() = %s, not a JSON object: %v", out, err)
+			}
+			if !reflect.DeepEqual(got["interface"], tt.want) {
+				t.Errorf("EmitFields() = %s, want interface %v", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmitFieldsUnknownPath(t *testing.T) {
+	if out, err := EmitFields(augmentDevice(), "/interface/speed-mbps"); err == nil {
+		t.Errorf("EmitFields(/interface/speed-mbps) = %s, want an error", out)
+	}
+}
+
+func TestMarshalJSONEnumAsInt(t *testing.T) {
+	d := augmentDevice()
+
+	names, err := MarshalJSON(d)
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+	ints, err := MarshalJSON(d, EnumAsInt())
+	if err != nil {
+		t.Fatalf("MarshalJSON(EnumAsInt()) error = %v", err)
+	}
+
+	var byName, byInt struct {
+		Interface map[string]interface{} `json:"interface"`
+	}
+	if err := json.Unmarshal(names, &byName); err != nil {
+		t.Fatalf("json.Unmarshal(%s) error = %v", names, err)
+	}
+	if err := json.Unmarshal(ints, &byInt); err != nil {
+		t.Fatalf("json.Unmarshal(%s) error = %v", ints, err)
+	}
+	if got := byName.Interface["status"]; got != "up" {
+		t.Errorf("MarshalJSON() status = %v, want up", got)
+	}
+	if got, want := byInt.Interface["status"], float64(NetworkDevice_Interface_Status_up); got != want {
+		t.Errorf("MarshalJSON(EnumAsInt()) status = %v, want %v", got, want)
+	}
+	// Other leaves are left alone.
+	delete(byName.Interface, "status")
+	delete(byInt.Interface, "status")
+	if !reflect.DeepEqual(byInt, byName) {
+		t.Errorf("MarshalJSON(EnumAsInt()) = %v, want %v apart from the status", byInt, byName)
+	}
+}
+
+func TestToPlainJSON(t *testing.T) {
+	d := augmentDevice()
+	d.Interface.IsManagement = true
+	d.Interface.LoadFactor = ygot.Float64(0.5)
+	d.Interface.GetOrCreateState().GetOrCreateCounters().InOctets = ygot.Uint64(100)
+
+	rfc7951, err := EmitRESTCONF(d)
+	if err != nil {
+		t.Fatalf("EmitRESTCONF() error = %v", err)
+	}
+	for _, want := range []string{`"network-device:interface"`, `"network-device-extensions:status": "up"`, `"is-management": [`, `"in-octets": "100"`} {
+		if !bytes.Contains(rfc7951, []byte(want)) {
+			t.Fatalf("EmitRESTCONF() = %s, want it to contain %s", rfc7951, want)
+		}
+	}
+
+	plain, err := ToPlainJSON(d)
+	if err != nil {
+		t.Fatalf("ToPlainJSON() error = %v", err)
+	}
+	var got map[string]interface{}
+	if err := json.Unmarshal(plain, &got); err != nil {
+		t.Fatalf("json.Unmarshal(%s) error = %v", plain, err)
+	}
+	want := map[string]interface{}{
+		"interface": map[string]interface{}{
+			"name":          "eth0",
+			"mtu":           float64(1500),
+			"priority":      float64(12),
+			"status":        "up",
+			"bandwidth":     float64(1000),
+			"is-management": true,
+			"load-factor":   0.5,
+			"state": map[string]interface{}{
+				"counters": map[string]interface{}{"in-octets": float64(100)},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToPlainJSON() = %s, want %v", plain, want)
+	}
+}
@@ -0,0 +1,31 @@
+package network
+
+import (
+	"reflect"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+// EnumValueMap returns, for each enumerated type used in the schema, a map of
+// its integer values to their YANG names. The outer map is keyed by the name
+// of the generated Go type, e.g. E_NetworkDevice_Interface_Status.
+func EnumValueMap() map[string]map[int64]string {
+	out := map[string]map[int64]string{}
+	for _, types := range ΛEnumTypes {
+		for _, t := range types {
+			if _, ok := out[t.Name()]; ok {
+				continue
+			}
+			e, ok := reflect.Zero(t).Interface().(ygot.GoEnum)
+			if !ok {
+				continue
+			}
+			values := map[int64]string{}
+			for v, def := range e.ΛMap()[t.Name()] {
+				values[v] = def.Name
+			}
+			out[t.Name()] = values
+		}
+	}
+	return out
+}
@@ -0,0 +1,21 @@
+package network
+
+import "testing"
+
+func TestEnumValueMap(t *testing.T) {
+	m := EnumValueMap()
+	status, ok := m["E_NetworkDevice_Interface_Status"]
+	if !ok {
+		t.Fatalf("EnumValueMap() has no E_NetworkDevice_Interface_Status, got %v", m)
+	}
+	up := int64(NetworkDevice_Interface_Status_up)
+	if got := status[up]; got != "up" {
+		t.Errorf("EnumValueMap()[status][%d] = %q, want %q", up, got, "up")
+	}
+	if len(status) != 4 {
+		t.Errorf("EnumValueMap()[status] = %v, want the 4 status values", status)
+	}
+	if _, ok := status[0]; ok {
+		t.Errorf("EnumValueMap()[status] = %v, want no UNSET value", status)
+	}
+}
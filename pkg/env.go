@@ -0,0 +1,105 @@
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/openconfig/goyang/pkg/yang"
+)
+
+// UnmarshalEnv sets the leaves of d from the environment variables named
+// after their path, in upper case with slashes and dashes replaced by
+// underscores, and preceded by prefix and an underscore: with prefix NET,
+// NET_INTERFACE_MTU=1500 sets /interface/mtu. Leaf-lists take a
+// comma-separated list, and empty leaves are set by true. Variables with the
+// prefix that match no leaf are ignored, as are the leaves of lists, which a
+// variable name cannot pick a member of. d is then validated with
+// ValidateDevice.
+func UnmarshalEnv(prefix string, d *Device) error {
+	leaves := map[string]*yang.Entry{}
+	keys := map[string]string{}
+	walkSchema(SchemaTree["Device"], "", func(path string, e *yang.Entry) {
+		if (e.IsLeaf() || e.IsLeafList()) && !inList(e) {
+			key := strings.ReplaceAll(strings.TrimPrefix(path, "/"), "/", "_")
+			name := prefix + "_" + strings.ToUpper(strings.ReplaceAll(key, "-", "_"))
+			leaves[name], keys[name] = e, key
+		}
+	})
+
+	var names []string
+	for _, kv := range os.Environ() {
+		if name, _, _ := strings.Cut(kv, "="); leaves[name] != nil {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return ValidateDevice(d)
+	}
+	sort.Strings(names)
+
+	flat := map[string]interface{}{}
+	for _, name := range names {
+		e := leaves[name]
+		s := os.Getenv(name)
+		if !e.IsLeafList() {
+			v, err := envValue(e.Type, s)
+			if err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+			flat[keys[name]] = v
+			continue
+		}
+		var values []interface{}
+		for _, item := range strings.Split(s, ",") {
+			v, err := envValue(e.Type, strings.TrimSpace(item))
+			if err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+			values = append(values, v)
+		}
+		flat[keys[name]] = values
+	}
+
+	data, err := json.Marshal(flat)
+	if err != nil {
+		return fmt.Errorf("cannot encode environment: %w", err)
+	}
+	if err := FromLegacyJSON(data, d); err != nil {
+		return err
+	}
+	return ValidateDevice(d)
+}
+
+// envValue converts s, the value of an environment variable, to the JSON
+// value of a leaf of type t. Values whose type takes a JSON string in RFC7951,
+// such as 64-bit integers, are passed on as is for Parse to check.
+func envValue(t *yang.YangType, s string) (interface{}, error) {
+	switch t.Kind {
+	case yang.Yint8, yang.Yint16, yang.Yint32:
+		if _, err := strconv.ParseInt(s, 10, 32); err != nil {
+			return nil, fmt.Errorf("invalid %v value %q", t.Kind, s)
+		}
+		return json.Number(s), nil
+	case yang.Yuint8, yang.Yuint16, yang.Yuint32:
+		if _, err := strconv.ParseUint(s, 10, 32); err != nil {
+			return nil, fmt.Errorf("invalid %v value %q", t.Kind, s)
+		}
+		return json.Number(s), nil
+	case yang.Ybool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid boolean value %q", s)
+		}
+		return b, nil
+	case yang.Yempty:
+		if b, err := strconv.ParseBool(s); err != nil || !b {
+			return nil, fmt.Errorf("invalid empty value %q, want true", s)
+		}
+		return []interface{}{nil}, nil
+	}
+	return s, nil
+}
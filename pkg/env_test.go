@@ -0,0 +1,54 @@
+package network
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestUnmarshalEnv(t *testing.T) {
+	t.Setenv("NET_INTERFACE_NAME", "eth0")
+	t.Setenv("NET_INTERFACE_MTU", "9000")
+	t.Setenv("NET_INTERFACE_LOAD_FACTOR", "0.25")
+	t.Setenv("NET_INTERFACE_STATUS", "up")
+	t.Setenv("NET_SYSTEM_DNS_SERVER", "ns1, ns2")
+	t.Setenv("NET_UNRELATED", "ignored")
+	t.Setenv("OTHER_INTERFACE_MTU", "1500")
+
+	var d Device
+	if err := UnmarshalEnv("NET", &d); err != nil {
+		t.Fatalf("UnmarshalEnv() error = %v", err)
+	}
+	i := d.GetInterface()
+	if i.GetName() != "eth0" || i.GetMtu() != 9000 || i.GetLoadFactor() != 0.25 || i.GetStatus() != NetworkDevice_Interface_Status_up {
+		t.Errorf("UnmarshalEnv() interface = %+v, want eth0, mtu 9000, load-factor 0.25 and status up", i)
+	}
+	if got := d.GetSystem().GetDnsServer(); len(got) != 2 || got[0] != "ns1" || got[1] != "ns2" {
+		t.Errorf("UnmarshalEnv() dns-server = %q, want [ns1 ns2]", got)
+	}
+}
+
+func TestUnmarshalEnvErrors(t *testing.T) {
+	for _, tt := range []struct {
+		desc  string
+		name  string
+		value string
+		want  string
+	}{
+		{"not a number", "NET_INTERFACE_MTU", "large", "NET_INTERFACE_MTU: invalid uint16 value"},
+		{"not a boolean", "NET_INTERFACE_ENABLED", "maybe", "NET_INTERFACE_ENABLED: invalid boolean value"},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			t.Setenv(tt.name, tt.value)
+			err := UnmarshalEnv("NET", &Device{})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("UnmarshalEnv(%s=%s) error = %v, want %q", tt.name, tt.value, err, tt.want)
+			}
+		})
+	}
+
+	t.Setenv("NET_INTERFACE_PRIORITY", "7")
+	if err := UnmarshalEnv("NET", &Device{}); !errors.Is(err, ErrValidation) {
+		t.Errorf("UnmarshalEnv(priority 7) error = %v, want ErrValidation", err)
+	}
+}
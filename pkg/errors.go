@@ -0,0 +1,25 @@
+package network
+
+import "errors"
+
+// Errors returned by the helpers of this package wrap one of the following
+// sentinels, so that callers can tell the kind of failure apart with
+// errors.Is. The wrapping error carries the details. The generated code, such
+// as Unmarshal and the Validate methods, does not wrap them: use Parse and
+// ValidateDevice instead.
+var (
+	// ErrSchemaNotFound is returned by Parse when a Go type has no entry in
+	// SchemaTree.
+	ErrSchemaNotFound = errors.New("schema not found")
+
+	// ErrValidation is returned when a device does not conform to the schema.
+	ErrValidation = errors.New("validation failed")
+
+	// ErrUnknownField is returned when JSON input holds a field that is not
+	// in the schema.
+	ErrUnknownField = errors.New("unknown field")
+
+	// ErrInvalidRequest is returned when a gNMI request cannot be applied to
+	// a device, e.g. because a path or a value does not fit the schema.
+	ErrInvalidRequest = errors.New("invalid request")
+)
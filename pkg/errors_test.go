@@ -0,0 +1,45 @@
+package network
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+)
+
+// unknownStruct is a GoStruct without an entry in SchemaTree.
+type unknownStruct struct{}
+
+func (*unknownStruct) IsYANGGoStruct() {}
+
+func TestErrorSentinels(t *testing.T) {
+	badPriority := &Device{}
+	badPriority.GetOrCreateInterface().Priority = ygot.Uint8(7)
+
+	tests := []struct {
+		desc string
+		err  error
+		want error
+	}{
+		{"schema not found", Parse([]byte(`{}`), &unknownStruct{}), ErrSchemaNotFound},
+		{"validation", ValidateDevice(badPriority), ErrValidation},
+		{"unknown top-level field", Parse([]byte(`{"bogus": 1}`), &Device{}), ErrUnknownField},
+		{"unknown nested field", Parse([]byte(`{"interface": {"colour": "red"}}`), &Device{}), ErrUnknownField},
+	}
+	for _, tt := range tests {
+		if !errors.Is(tt.err, tt.want) {
+			t.Errorf("%s: error = %v, want %v", tt.desc, tt.err, tt.want)
+		}
+	}
+}
+
+func TestParseIgnoreExtraFields(t *testing.T) {
+	d := &Device{}
+	if err := Parse([]byte(`{"interface": {"name": "eth0", "bogus": 1}}`), d, &ytypes.IgnoreExtraFields{}); err != nil {
+		t.Fatalf("Parse() with IgnoreExtraFields error = %v", err)
+	}
+	if d.GetInterface().GetName() != "eth0" {
+		t.Errorf("name = %q, want eth0", d.GetInterface().GetName())
+	}
+}
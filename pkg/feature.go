@@ -0,0 +1,78 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/openconfig/goyang/pkg/yang"
+)
+
+// ValidateWithFeatures validates d like ValidateDevice, for a device that
+// supports only the YANG features named in enabled, e.g. "jumbo-frames". Leaves
+// guarded by an if-feature statement of a disabled feature, on themselves or
+// on any of their ancestors, must not be set, and the MTU must be outside the
+// range of the jumbo-mtu state leaf unless the features guarding that leaf
+// are enabled. Errors wrap ErrValidation.
+func ValidateWithFeatures(d *Device, enabled ...string) error {
+	on := make(map[string]bool, len(enabled))
+	for _, f := range enabled {
+		on[f] = true
+	}
+
+	var errs []error
+	Walk(d, func(path string, _ interface{}) error {
+		elems := strings.Split(strings.TrimPrefix(path, "/"), "/")
+		for i := range elems {
+			_, e, err := resolvePath("/" + strings.Join(elems[:i+1], "/"))
+			if err != nil {
+				return nil
+			}
+			for _, f := range entryIfFeatures(e) {
+				if !on[f] {
+					errs = append(errs, fmt.Errorf("%s: requires feature %s", path, f))
+				}
+			}
+		}
+		return nil
+	})
+	if i := d.GetInterface(); i != nil && i.Mtu != nil {
+		// The range of jumbo-mtu is the MTUs of jumbo frames, which YANG
+		// cannot gate on the mtu leaf itself.
+		jumbo := SchemaTree["NetworkDevice_Interface_State"].Dir["jumbo-mtu"]
+		if inRange(jumbo.Type, yang.FromUint(uint64(*i.Mtu))) {
+			for _, f := range entryIfFeatures(jumbo) {
+				if !on[f] {
+					errs = append(errs, fmt.Errorf("/interface/mtu: %d is in the range of /interface/state/jumbo-mtu, which requires feature %s", *i.Mtu, f))
+				}
+			}
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+	return ValidateDevice(d)
+}
+
+// entryIfFeatures returns the names of the features in the if-feature
+// statements of e, which goyang keeps in the Extra field of the entry, like
+// the status statement read by entryStatus. Prefixes are removed.
+func entryIfFeatures(e *yang.Entry) []string {
+	var names []string
+	for _, s := range e.Extra["if-feature"] {
+		var name string
+		switch v := s.(type) {
+		case *yang.Value:
+			name = v.Name
+		case map[string]interface{}:
+			name, _ = v["Name"].(string)
+		}
+		if _, local, ok := strings.Cut(name, ":"); ok {
+			name = local
+		}
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
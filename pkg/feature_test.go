@@ -0,0 +1,66 @@
+package network
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ygot"
+)
+
+func TestValidateWithFeatures(t *testing.T) {
+	found := false
+	for _, f := range readBaseModule(t).Feature {
+		found = found || f.Name == "jumbo-frames"
+	}
+	if !found {
+		t.Fatal("base.yang does not declare the jumbo-frames feature")
+	}
+
+	for _, tt := range []struct {
+		desc     string
+		mtu      uint16
+		jumboMtu *uint16
+		enabled  []string
+		wantErr  bool
+	}{
+		{"mtu 9000 without jumbo-frames", 9000, nil, nil, true},
+		{"mtu 9000 with other features", 9000, nil, []string{"other"}, true},
+		{"mtu 9000 with jumbo-frames", 9000, nil, []string{"jumbo-frames"}, false},
+		{"mtu 1500 without jumbo-frames", 1500, nil, nil, false},
+		{"jumbo-mtu without jumbo-frames", 1500, ygot.Uint16(9216), nil, true},
+		{"jumbo-mtu with jumbo-frames", 1500, ygot.Uint16(9216), []string{"jumbo-frames"}, false},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			d := augmentDevice()
+			d.Interface.Mtu = ygot.Uint16(tt.mtu)
+			if tt.jumboMtu != nil {
+				d.Interface.GetOrCreateState().JumboMtu = tt.jumboMtu
+			}
+			err := ValidateWithFeatures(d, tt.enabled...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateWithFeatures(%q) error = %v, want error %v", tt.enabled, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrValidation) {
+				t.Errorf("ValidateWithFeatures(%q) error = %v, want ErrValidation", tt.enabled, err)
+			}
+		})
+	}
+}
+
+func TestEntryIfFeatures(t *testing.T) {
+	e := &yang.Entry{Extra: map[string][]interface{}{
+		"if-feature": {&yang.Value{Name: "net:jumbo-frames"}, map[string]interface{}{"Name": "other"}},
+	}}
+	got := entryIfFeatures(e)
+	if len(got) != 2 || got[0] != "jumbo-frames" || got[1] != "other" {
+		t.Errorf("entryIfFeatures() = %q, want [jumbo-frames other]", got)
+	}
+	if got := entryIfFeatures(SchemaTree["NetworkDevice_Interface"]); len(got) != 0 {
+		t.Errorf("entryIfFeatures(interface) = %q, want none", got)
+	}
+	got = entryIfFeatures(SchemaTree["NetworkDevice_Interface_State"].Dir["jumbo-mtu"])
+	if len(got) != 1 || got[0] != "jumbo-frames" {
+		t.Errorf("entryIfFeatures(jumbo-mtu) = %q, want [jumbo-frames]", got)
+	}
+}
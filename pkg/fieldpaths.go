@@ -0,0 +1,36 @@
+package network
+
+import (
+	"reflect"
+)
+
+// FieldPaths maps the Go field names of the generated structs, relative to
+// Device, to their schema paths, e.g. "Interface.Mtu" to "/interface/mtu". It
+// is built from the path tags of the generated structs.
+var FieldPaths = fieldPaths(reflect.TypeOf(Device{}), "", "")
+
+func fieldPaths(t reflect.Type, goPrefix, pathPrefix string) map[string]string {
+	out := map[string]string{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, ok := f.Tag.Lookup("path")
+		if !ok {
+			continue
+		}
+		name := goPrefix + f.Name
+		path := pathPrefix + "/" + tag
+		out[name] = path
+
+		// Descend into containers and lists.
+		ft := f.Type
+		if ft.Kind() == reflect.Map {
+			ft = ft.Elem()
+		}
+		if ft.Kind() == reflect.Ptr && ft.Elem().Kind() == reflect.Struct {
+			for k, v := range fieldPaths(ft.Elem(), name+".", path) {
+				out[k] = v
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,17 @@
+package network
+
+import "testing"
+
+func TestFieldPaths(t *testing.T) {
+	for field, want := range map[string]string{
+		"Interface":                         "/interface",
+		"Interface.Mtu":                     "/interface/mtu",
+		"Interface.Name":                    "/interface/name",
+		"Interface.Status":                  "/interface/status",
+		"Interface.State.Counters.InOctets": "/interface/state/counters/in-octets",
+	} {
+		if got, ok := FieldPaths[field]; !ok || got != want {
+			t.Errorf("FieldPaths[%q] = %q, %v, want %q", field, got, ok, want)
+		}
+	}
+}
@@ -0,0 +1,240 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"reflect"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+	"google.golang.org/protobuf/proto"
+)
+
+// SetRequestOpt is an option that modifies the behaviour of ToSetRequest.
+type SetRequestOpt func(*setRequestConfig)
+
+type setRequestConfig struct {
+	origin   bool
+	compress bool
+}
+
+// WithOrigin sets the Origin of every generated path to the name of the YANG
+// module that defines its top-level node, e.g. "network-device". Targets that
+// serve more than one origin need this to route the request.
+func WithOrigin() SetRequestOpt {
+	return func(c *setRequestConfig) { c.origin = true }
+}
+
+// CompressPaths drops the config and state containers from the generated
+// paths, as OpenConfig path compression does, so that /interface/state/counters
+// becomes /interface/counters. Only use it with targets that serve the
+// compressed form of the schema, since the resulting paths are not valid in
+// the uncompressed one that this package, and ApplySetRequest, use.
+func CompressPaths() SetRequestOpt {
+	return func(c *setRequestConfig) { c.compress = true }
+}
+
+// ToSetRequest renders every populated leaf of d as an update within a gNMI
+// SetRequest.
+func ToSetRequest(d *Device, opts ...SetRequestOpt) (*gnmi.SetRequest, error) {
+	cfg := &setRequestConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	notifs, err := ygot.TogNMINotifications(d, 0, ygot.GNMINotificationsConfig{UsePathElem: true})
+	if err != nil {
+		return nil, fmt.Errorf("cannot render device as notifications: %w", err)
+	}
+
+	req := &gnmi.SetRequest{}
+	for _, n := range notifs {
+		for _, u := range n.GetUpdate() {
+			p := &gnmi.Path{
+				Elem: append(append([]*gnmi.PathElem{}, n.GetPrefix().GetElem()...), u.GetPath().GetElem()...),
+			}
+			if cfg.compress {
+				p.Elem = compressElems(p.Elem)
+			}
+			if cfg.origin && len(p.Elem) > 0 {
+				p.Origin = belongingModule(d, p.Elem[0].GetName())
+			}
+			req.Update = append(req.Update, &gnmi.Update{Path: p, Val: u.GetVal()})
+		}
+	}
+	return req, nil
+}
+
+// compressElems returns elems without the config and state containers. The
+// last element is always kept, as it is the leaf itself.
+func compressElems(elems []*gnmi.PathElem) []*gnmi.PathElem {
+	out := make([]*gnmi.PathElem, 0, len(elems))
+	for i, e := range elems {
+		if i < len(elems)-1 && (e.GetName() == "config" || e.GetName() == "state") {
+			continue
+		}
+		out = append(out, e)
+	}
+	return out
+}
+
+// LeafUpdates returns an iterator over the populated leaves of d, yielding
+// the gNMI path and value of each, in the order Walk visits them. Unlike
+// ToSetRequest, it encodes each leaf only when the iteration reaches it, so
+// a consumer can stream updates and stop early. A leaf whose value cannot be
+// encoded is yielded with a nil value. The returned function reports the
+// error that ended the last iteration early, if any, e.g. a list member whose
+// keys cannot be read; it is nil after a complete iteration or a break.
+func LeafUpdates(d *Device) (iter.Seq2[*gnmi.Path, *gnmi.TypedValue], func() error) {
+	var walkErr error
+	seq := func(yield func(*gnmi.Path, *gnmi.TypedValue) bool) {
+		errStop := errors.New("stop")
+		err := walkElems(d, func(elems []*gnmi.PathElem, value interface{}) error {
+			p := &gnmi.Path{Elem: make([]*gnmi.PathElem, len(elems))}
+			for i, e := range elems {
+				p.Elem[i] = proto.Clone(e).(*gnmi.PathElem)
+			}
+			tv, err := ygot.EncodeTypedValue(value, gnmi.Encoding_JSON_IETF)
+			if err != nil {
+				tv = nil
+			}
+			if !yield(p, tv) {
+				return errStop
+			}
+			return nil
+		})
+		if errors.Is(err, errStop) {
+			err = nil
+		}
+		walkErr = err
+	}
+	return seq, func() error { return walkErr }
+}
+
+// Capabilities returns the CapabilityResponse of a gNMI target serving the
+// model of this package: the network-device module, with its organization
+// and the date of its latest revision as version, in the JSON_IETF encoding.
+func Capabilities() *gnmi.CapabilityResponse {
+	return &gnmi.CapabilityResponse{
+		SupportedModels: []*gnmi.ModelData{{
+			Name:         "network-device",
+			Organization: moduleOrganization,
+			Version:      moduleRevision,
+		}},
+		SupportedEncodings: []gnmi.Encoding{gnmi.Encoding_JSON_IETF},
+	}
+}
+
+// ApplyOpt is an option that modifies the behaviour of ApplySetRequest.
+type ApplyOpt func(*applyConfig)
+
+type applyConfig struct {
+	dryRun bool
+}
+
+// DryRun makes ApplySetRequest work on a copy of the device and leave the
+// original untouched, so that the outcome of a request can be previewed.
+func DryRun() ApplyOpt {
+	return func(c *applyConfig) { c.dryRun = true }
+}
+
+// ApplySetRequest applies the deletes, replaces and updates of req to d, in
+// that order, following gNMI semantics: a replace of /interface clears every
+// interface leaf that it does not set. The result must pass validation,
+// otherwise d is left unchanged and the error, wrapping ErrValidation, is
+// returned. A request that cannot be applied yields ErrInvalidRequest. It
+// returns the resulting device, which is d itself unless DryRun is given. In
+// a dry run, the resulting device is returned even if it fails validation.
+func ApplySetRequest(d *Device, req *gnmi.SetRequest, opts ...ApplyOpt) (*Device, error) {
+	cfg := &applyConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	cp, err := ygot.DeepCopy(d)
+	if err != nil {
+		return nil, fmt.Errorf("cannot copy device: %w", err)
+	}
+	candidate := cp.(*Device)
+
+	schema, err := Schema()
+	if err != nil {
+		return nil, fmt.Errorf("cannot load schema: %w", err)
+	}
+	schema.Root = candidate
+	if err := ytypes.UnmarshalSetRequest(schema, req); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+
+	err = ValidateDevice(candidate)
+	if cfg.dryRun {
+		return candidate, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	*d = *candidate
+	return d, nil
+}
+
+// ApplySubscribeResponse applies the updates and deletes of the notification
+// in r to d. Sync responses, which only mark the end of the initial updates,
+// are ignored. The result is not validated, since telemetry mirrors the state
+// of the target as is, but d is only changed if the whole notification
+// applies.
+func ApplySubscribeResponse(d *Device, r *gnmi.SubscribeResponse) error {
+	n := r.GetUpdate()
+	if n == nil {
+		return nil
+	}
+
+	cp, err := ygot.DeepCopy(d)
+	if err != nil {
+		return fmt.Errorf("cannot copy device: %w", err)
+	}
+	candidate := cp.(*Device)
+
+	schema, err := Schema()
+	if err != nil {
+		return fmt.Errorf("cannot load schema: %w", err)
+	}
+	schema.Root = candidate
+	if err := ytypes.UnmarshalNotifications(schema, []*gnmi.Notification{n}); err != nil {
+		return fmt.Errorf("cannot apply notification: %w", err)
+	}
+	*d = *candidate
+	return nil
+}
+
+// belongingModule returns the module that defines the top-level node name of
+// the device, as reported by the ΛBelongingModule method of its GoStruct.
+func belongingModule(d *Device, name string) string {
+	v := reflect.ValueOf(d).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).Tag.Get("path") != name {
+			continue
+		}
+		if s, ok := v.Field(i).Interface().(ygot.ValidatedGoStruct); ok {
+			return s.ΛBelongingModule()
+		}
+	}
+	return ""
+}
+
+// Reconcile returns the SetRequest that moves current to desired, made of a
+// delete for every leaf only set in current and an update for every leaf that
+// is new or changed in desired. The bool is false, and the request empty, when
+// the devices are already equal.
+func Reconcile(current, desired *Device) (*gnmi.SetRequest, bool, error) {
+	n, err := ygot.Diff(current, desired)
+	if err != nil {
+		return nil, false, fmt.Errorf("cannot diff devices: %w", err)
+	}
+	req := &gnmi.SetRequest{
+		Delete: n.GetDelete(),
+		Update: n.GetUpdate(),
+	}
+	return req, len(req.Delete)+len(req.Update) > 0, nil
+}
@@ -0,0 +1,374 @@
+package network
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestToSetRequestOrigin(t *testing.T) {
+	d := &Device{}
+	iface := d.GetOrCreateInterface()
+	iface.Name = ygot.String("eth0")
+	iface.Status = NetworkDevice_Interface_Status_up
+
+	tests := []struct {
+		desc string
+		opts []SetRequestOpt
+		want string
+	}{
+		{"with origin", []SetRequestOpt{WithOrigin()}, "network-device"},
+		{"without origin", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			req, err := ToSetRequest(d, tt.opts...)
+			if err != nil {
+				t.Fatalf("ToSetRequest() error = %v", err)
+			}
+			if len(req.GetUpdate()) != 2 {
+				t.Fatalf("ToSetRequest() = %d updates, want 2", len(req.GetUpdate()))
+			}
+			for _, u := range req.GetUpdate() {
+				if got := u.GetPath().GetOrigin(); got != tt.want {
+					t.Errorf("origin of %v = %q, want %q", u.GetPath(), got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestToSetRequestCompressPaths(t *testing.T) {
+	d := &Device{}
+	iface := d.GetOrCreateInterface()
+	iface.Name = ygot.String("eth0")
+	iface.GetOrCreateState().GetOrCreateCounters().InOctets = ygot.Uint64(100)
+
+	tests := []struct {
+		desc string
+		opts []SetRequestOpt
+		want []string
+	}{
+		{"uncompressed", nil, []string{"/interface/name", "/interface/state/counters/in-octets"}},
+		{"compressed", []SetRequestOpt{CompressPaths()}, []string{"/interface/counters/in-octets", "/interface/name"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			req, err := ToSetRequest(d, tt.opts...)
+			if err != nil {
+				t.Fatalf("ToSetRequest() error = %v", err)
+			}
+			var got []string
+			for _, u := range req.GetUpdate() {
+				p, err := ygot.PathToString(u.GetPath())
+				if err != nil {
+					t.Fatalf("PathToString(%v) error = %v", u.GetPath(), err)
+				}
+				got = append(got, p)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToSetRequest() paths = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLeafUpdates(t *testing.T) {
+	// The device of the build example.
+	d := &Device{}
+	iface := d.GetOrCreateInterface()
+	iface.Name = ygot.String("eth0")
+	iface.Mtu = ygot.Uint16(1500)
+	iface.Priority = ygot.Uint8(3)
+
+	// A key that ends a path string must not cut the iteration short.
+	iface.SetTag("a]b", "x")
+
+	got := map[string]*gnmi.TypedValue{}
+	updates, errf := LeafUpdates(d)
+	for p, v := range updates {
+		s, err := ygot.PathToString(p)
+		if err != nil {
+			t.Fatalf("PathToString(%v) error = %v", p, err)
+		}
+		got[s] = v
+	}
+	if err := errf(); err != nil {
+		t.Fatalf("LeafUpdates() error = %v", err)
+	}
+
+	req, err := ToSetRequest(d)
+	if err != nil {
+		t.Fatalf("ToSetRequest() error = %v", err)
+	}
+	if len(got) != 5 || len(req.GetUpdate()) != len(got) {
+		t.Fatalf("LeafUpdates() = %v, want the %d updates of ToSetRequest", got, len(req.GetUpdate()))
+	}
+	for _, u := range req.GetUpdate() {
+		s, err := ygot.PathToString(u.GetPath())
+		if err != nil {
+			t.Fatalf("PathToString(%v) error = %v", u.GetPath(), err)
+		}
+		if v, ok := got[s]; !ok || !proto.Equal(v, u.GetVal()) {
+			t.Errorf("LeafUpdates()[%s] = %v, want %v", s, v, u.GetVal())
+		}
+	}
+
+	var n int
+	for range updates {
+		n++
+		break
+	}
+	if n != 1 {
+		t.Errorf("LeafUpdates() after break yielded %d pairs, want 1", n)
+	}
+	if err := errf(); err != nil {
+		t.Errorf("LeafUpdates() error after break = %v, want nil", err)
+	}
+	updates, errf = LeafUpdates(nil)
+	for p, v := range updates {
+		t.Errorf("LeafUpdates(nil) yielded %v, %v, want nothing", p, v)
+	}
+	if err := errf(); err != nil {
+		t.Errorf("LeafUpdates(nil) error = %v, want nil", err)
+	}
+}
+
+func TestCapabilities(t *testing.T) {
+	m := readBaseModule(t)
+	resp := Capabilities()
+
+	models := resp.GetSupportedModels()
+	if len(models) != 1 {
+		t.Fatalf("Capabilities() models = %v, want one", models)
+	}
+	want := &gnmi.ModelData{Name: m.Name, Organization: m.Organization.Name, Version: m.Revision[0].Name}
+	if !proto.Equal(models[0], want) {
+		t.Errorf("Capabilities() model = %v, want %v", models[0], want)
+	}
+	if enc := resp.GetSupportedEncodings(); len(enc) != 1 || enc[0] != gnmi.Encoding_JSON_IETF {
+		t.Errorf("Capabilities() encodings = %v, want [JSON_IETF]", enc)
+	}
+}
+
+// mustPath parses the gNMI path s, failing t on error.
+func mustPath(t *testing.T, s string) *gnmi.Path {
+	t.Helper()
+	p, err := ygot.StringToStructuredPath(s)
+	if err != nil {
+		t.Fatalf("StringToStructuredPath(%s) error = %v", s, err)
+	}
+	return p
+}
+
+func uintVal(v uint64) *gnmi.TypedValue {
+	return &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: v}}
+}
+
+func TestApplySetRequest(t *testing.T) {
+	tests := []struct {
+		desc    string
+		req     func(t *testing.T) *gnmi.SetRequest
+		wantErr error
+		check   func(t *testing.T, d *Device)
+	}{{
+		desc: "update",
+		req: func(t *testing.T) *gnmi.SetRequest {
+			return &gnmi.SetRequest{Update: []*gnmi.Update{{Path: mustPath(t, "/interface/mtu"), Val: uintVal(9000)}}}
+		},
+		check: func(t *testing.T, d *Device) {
+			if d.Interface.GetMtu() != 9000 || d.Interface.GetName() != "eth0" {
+				t.Errorf("mtu, name = %d, %q, want 9000, eth0", d.Interface.GetMtu(), d.Interface.GetName())
+			}
+		},
+	}, {
+		desc: "delete",
+		req: func(t *testing.T) *gnmi.SetRequest {
+			return &gnmi.SetRequest{Delete: []*gnmi.Path{mustPath(t, "/interface/priority")}}
+		},
+		check: func(t *testing.T, d *Device) {
+			if d.Interface.Priority != nil || d.Interface.GetMtu() != 1500 {
+				t.Errorf("priority, mtu = %v, %d, want unset, 1500", d.Interface.Priority, d.Interface.GetMtu())
+			}
+		},
+	}, {
+		desc: "replace clears unset leaves",
+		req: func(t *testing.T) *gnmi.SetRequest {
+			return &gnmi.SetRequest{Replace: []*gnmi.Update{{
+				Path: mustPath(t, "/interface"),
+				Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`{"name": "eth1", "mtu": 9000}`)}},
+			}}}
+		},
+		check: func(t *testing.T, d *Device) {
+			i := d.Interface
+			if i.GetName() != "eth1" || i.GetMtu() != 9000 || i.Priority != nil || i.Status != nil {
+				t.Errorf("interface = name %q, mtu %d, priority %v, status %v, want eth1, 9000 and nothing else", i.GetName(), i.GetMtu(), i.Priority, i.Status)
+			}
+		},
+	}, {
+		desc: "delete, replace and update in order",
+		req: func(t *testing.T) *gnmi.SetRequest {
+			return &gnmi.SetRequest{
+				Delete:  []*gnmi.Path{mustPath(t, "/interface/mtu")},
+				Replace: []*gnmi.Update{{Path: mustPath(t, "/interface/mtu"), Val: uintVal(4000)}},
+				Update:  []*gnmi.Update{{Path: mustPath(t, "/interface/mtu"), Val: uintVal(9000)}},
+			}
+		},
+		check: func(t *testing.T, d *Device) {
+			if d.Interface.GetMtu() != 9000 {
+				t.Errorf("mtu = %d, want the update to win with 9000", d.Interface.GetMtu())
+			}
+		},
+	}, {
+		desc: "invalid result",
+		req: func(t *testing.T) *gnmi.SetRequest {
+			return &gnmi.SetRequest{Update: []*gnmi.Update{{Path: mustPath(t, "/interface/priority"), Val: uintVal(7)}}}
+		},
+		wantErr: ErrValidation,
+	}, {
+		desc: "unknown path",
+		req: func(t *testing.T) *gnmi.SetRequest {
+			return &gnmi.SetRequest{Update: []*gnmi.Update{{Path: mustPath(t, "/interface/speed-mbps"), Val: uintVal(7)}}}
+		},
+		wantErr: ErrInvalidRequest,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			d := augmentDevice()
+			got, err := ApplySetRequest(d, tt.req(t))
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ApplySetRequest() error = %v, want %v", err, tt.wantErr)
+				}
+				if n, _ := ygot.Diff(augmentDevice(), d); len(n.GetUpdate())+len(n.GetDelete()) != 0 {
+					t.Errorf("device changed by a failed request: %v", n)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ApplySetRequest() error = %v", err)
+			}
+			if got != d {
+				t.Errorf("ApplySetRequest() returned a different device than d")
+			}
+			tt.check(t, d)
+		})
+	}
+}
+
+func TestApplySetRequestDryRun(t *testing.T) {
+	for _, tt := range []struct {
+		desc     string
+		priority uint64
+		wantErr  bool
+	}{
+		{"valid", 14, false},
+		{"invalid", 7, true},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			d := augmentDevice()
+			req := &gnmi.SetRequest{Update: []*gnmi.Update{{Path: mustPath(t, "/interface/priority"), Val: uintVal(tt.priority)}}}
+			got, err := ApplySetRequest(d, req, DryRun())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ApplySetRequest(DryRun) error = %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrValidation) {
+				t.Errorf("ApplySetRequest(DryRun) error = %v, want ErrValidation", err)
+			}
+			if got == nil || got == d {
+				t.Fatalf("ApplySetRequest(DryRun) = %p, want a copy of d", got)
+			}
+			if got.Interface.GetPriority() != uint8(tt.priority) {
+				t.Errorf("ApplySetRequest(DryRun) priority = %d, want %d", got.Interface.GetPriority(), tt.priority)
+			}
+			if n, _ := ygot.Diff(augmentDevice(), d); len(n.GetUpdate())+len(n.GetDelete()) != 0 {
+				t.Errorf("device changed by a dry run: %v", n)
+			}
+		})
+	}
+}
+
+func TestApplySubscribeResponse(t *testing.T) {
+	notification := func(n *gnmi.Notification) *gnmi.SubscribeResponse {
+		return &gnmi.SubscribeResponse{Response: &gnmi.SubscribeResponse_Update{Update: n}}
+	}
+	d := augmentDevice()
+
+	for _, r := range []*gnmi.SubscribeResponse{
+		notification(&gnmi.Notification{Update: []*gnmi.Update{
+			{Path: mustPath(t, "/interface/state/counters/in-octets"), Val: uintVal(100)},
+			{Path: mustPath(t, "/interface/state/counters/out-octets"), Val: uintVal(200)},
+		}}),
+		notification(&gnmi.Notification{Update: []*gnmi.Update{
+			{Path: mustPath(t, "/interface/state/counters/in-octets"), Val: uintVal(150)},
+		}}),
+		{Response: &gnmi.SubscribeResponse_SyncResponse{SyncResponse: true}},
+	} {
+		if err := ApplySubscribeResponse(d, r); err != nil {
+			t.Fatalf("ApplySubscribeResponse(%v) error = %v", r, err)
+		}
+	}
+	if c := d.Interface.GetState().GetCounters(); c.GetInOctets() != 150 || c.GetOutOctets() != 200 {
+		t.Errorf("counters after the updates = %d, %d, want 150, 200", c.GetInOctets(), c.GetOutOctets())
+	}
+
+	del := notification(&gnmi.Notification{Delete: []*gnmi.Path{mustPath(t, "/interface/state/counters/out-octets")}})
+	if err := ApplySubscribeResponse(d, del); err != nil {
+		t.Fatalf("ApplySubscribeResponse(delete) error = %v", err)
+	}
+	if c := d.Interface.GetState().GetCounters(); c.GetInOctets() != 150 || c.OutOctets != nil {
+		t.Errorf("counters after the delete = %v, %v, want 150, unset", c.GetInOctets(), c.OutOctets)
+	}
+}
+
+func TestApplySubscribeResponseUnknownPath(t *testing.T) {
+	d := augmentDevice()
+	r := &gnmi.SubscribeResponse{Response: &gnmi.SubscribeResponse_Update{Update: &gnmi.Notification{Update: []*gnmi.Update{
+		{Path: mustPath(t, "/interface/mtu"), Val: uintVal(9000)},
+		{Path: mustPath(t, "/interface/speed-mbps"), Val: uintVal(100)},
+	}}}}
+	if err := ApplySubscribeResponse(d, r); err == nil {
+		t.Fatal("ApplySubscribeResponse(unknown path) error = nil, want an error")
+	}
+	if got := d.Interface.GetMtu(); got != 1500 {
+		t.Errorf("mtu after a failed notification = %d, want 1500", got)
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	current := augmentDevice()
+	req, changed, err := Reconcile(current, augmentDevice())
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if changed || len(req.GetUpdate())+len(req.GetDelete()) != 0 {
+		t.Errorf("Reconcile(equal devices) = %v, %v, want no changes", req, changed)
+	}
+
+	desired := augmentDevice()
+	desired.Interface.Mtu = ygot.Uint16(9000)
+	req, changed, err = Reconcile(current, desired)
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if !changed || len(req.GetUpdate()) != 1 || len(req.GetDelete()) != 0 {
+		t.Fatalf("Reconcile() = %v, %v, want a single update", req, changed)
+	}
+	if p, _ := ygot.PathToString(req.Update[0].GetPath()); p != "/interface/mtu" || req.Update[0].GetVal().GetUintVal() != 9000 {
+		t.Errorf("Reconcile() update = %s: %v, want /interface/mtu: 9000", p, req.Update[0].GetVal())
+	}
+
+	// Applying the request moves current to desired.
+	if _, err := ApplySetRequest(current, req); err != nil {
+		t.Fatalf("ApplySetRequest() error = %v", err)
+	}
+	if _, changed, _ := Reconcile(current, desired); changed {
+		t.Error("Reconcile() after applying the request reports changes")
+	}
+}
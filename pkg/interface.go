@@ -0,0 +1,141 @@
+package network
+
+import (
+	"fmt"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ygot"
+)
+
+// The generated GetXXX methods return the Go zero value for unset leaves. The
+// OrDefault variants below return the supplied default instead.
+
+// GetBandwidthOrDefault returns the Bandwidth leaf, or def if it is unset.
+func (t *NetworkDevice_Interface) GetBandwidthOrDefault(def uint32) uint32 {
+	if t == nil || t.Bandwidth == nil {
+		return def
+	}
+	return *t.Bandwidth
+}
+
+// GetMtuOrDefault returns the Mtu leaf, or def if it is unset.
+func (t *NetworkDevice_Interface) GetMtuOrDefault(def uint16) uint16 {
+	if t == nil || t.Mtu == nil {
+		return def
+	}
+	return *t.Mtu
+}
+
+// GetNameOrDefault returns the Name leaf, or def if it is unset.
+func (t *NetworkDevice_Interface) GetNameOrDefault(def string) string {
+	if t == nil || t.Name == nil {
+		return def
+	}
+	return *t.Name
+}
+
+// GetPriorityOrDefault returns the Priority leaf, or def if it is unset.
+func (t *NetworkDevice_Interface) GetPriorityOrDefault(def uint8) uint8 {
+	if t == nil || t.Priority == nil {
+		return def
+	}
+	return *t.Priority
+}
+
+// ClearBandwidth unsets the Bandwidth leaf.
+func (t *NetworkDevice_Interface) ClearBandwidth() { t.Bandwidth = nil }
+
+// ClearMtu unsets the Mtu leaf.
+func (t *NetworkDevice_Interface) ClearMtu() { t.Mtu = nil }
+
+// ClearName unsets the Name leaf.
+func (t *NetworkDevice_Interface) ClearName() { t.Name = nil }
+
+// ClearPriority unsets the Priority leaf.
+func (t *NetworkDevice_Interface) ClearPriority() { t.Priority = nil }
+
+// InPriorityRange reports whether v is a valid Priority leaf, by the range of
+// the priority-level type in the schema, so that callers can check a value
+// with the same rule as Validate before setting it.
+func InPriorityRange(v uint8) bool {
+	return inRange(SchemaTree["NetworkDevice_Interface"].Dir["priority"].Type, yang.FromUint(uint64(v)))
+}
+
+// The Status leaf, added by the extensions module, is a union of enumerated
+// values and a free-form maintenance-* string. The helpers below set each.
+
+// SetStatusUp sets the Status leaf to up.
+func (t *NetworkDevice_Interface) SetStatusUp() {
+	t.Status = NetworkDevice_Interface_Status_up
+}
+
+// SetStatusDown sets the Status leaf to down.
+func (t *NetworkDevice_Interface) SetStatusDown() {
+	t.Status = NetworkDevice_Interface_Status_down
+}
+
+// SetStatusTesting sets the Status leaf to testing.
+func (t *NetworkDevice_Interface) SetStatusTesting() {
+	t.Status = NetworkDevice_Interface_Status_testing
+}
+
+// SetStatusDormant sets the Status leaf to dormant.
+func (t *NetworkDevice_Interface) SetStatusDormant() {
+	t.Status = NetworkDevice_Interface_Status_dormant
+}
+
+// SetStatusMaintenance sets the Status leaf to the free-form string
+// maintenance-<reason>, e.g. maintenance-scheduled for "scheduled".
+func (t *NetworkDevice_Interface) SetStatusMaintenance(reason string) {
+	t.Status = UnionString("maintenance-" + reason)
+}
+
+// The tags container holds a keyed list of labels. The helpers below treat it
+// as a map from key to value.
+
+// SetTag sets the tag k to v, replacing its value if k is already set.
+func (t *NetworkDevice_Interface) SetTag(k, v string) {
+	t.GetOrCreateTags().GetOrCreateTag(k).Value = ygot.String(v)
+}
+
+// GetTag returns the value of the tag k, and whether k is set.
+func (t *NetworkDevice_Interface) GetTag(k string) (string, bool) {
+	tag := t.GetTags().GetTag(k)
+	if tag == nil {
+		return "", false
+	}
+	return tag.GetValue(), true
+}
+
+// TagMap returns the tags of the interface as a map from key to value, or
+// nil if it has none.
+func (t *NetworkDevice_Interface) TagMap() map[string]string {
+	tags := t.GetTags()
+	if tags == nil || len(tags.Tag) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(tags.Tag))
+	for k, tag := range tags.Tag {
+		out[k] = tag.GetValue()
+	}
+	return out
+}
+
+// Shutdown administratively disables the interface of d called name, setting
+// enabled to false, and marks its operational status as down. d is then
+// validated with ValidateDevice, and left as it was if that fails, e.g.
+// because the interface has a vlan-id, which requires it to be enabled.
+func Shutdown(d *Device, name string) error {
+	i := d.GetInterface()
+	if i == nil || i.GetName() != name {
+		return fmt.Errorf("interface %q not found", name)
+	}
+	enabled, status := i.Enabled, i.Status
+	i.Enabled = ygot.Bool(false)
+	i.Status = NetworkDevice_Interface_Status_down
+	if err := ValidateDevice(d); err != nil {
+		i.Enabled, i.Status = enabled, status
+		return err
+	}
+	return nil
+}
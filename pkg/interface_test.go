@@ -0,0 +1,181 @@
+package network
+
+import (
+	"errors"
+	"maps"
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+func TestOrDefaultGetters(t *testing.T) {
+	var unset, nilIface *NetworkDevice_Interface = &NetworkDevice_Interface{}, nil
+	set := &NetworkDevice_Interface{
+		Bandwidth: ygot.Uint32(1000),
+		Mtu:       ygot.Uint16(9000),
+		Name:      ygot.String("eth0"),
+		Priority:  ygot.Uint8(12),
+	}
+
+	for _, tt := range []struct {
+		desc  string
+		iface *NetworkDevice_Interface
+		want  *NetworkDevice_Interface
+	}{
+		{"set", set, set},
+		{"unset", unset, &NetworkDevice_Interface{Bandwidth: ygot.Uint32(1), Mtu: ygot.Uint16(1500), Name: ygot.String("none"), Priority: ygot.Uint8(1)}},
+		{"nil interface", nilIface, &NetworkDevice_Interface{Bandwidth: ygot.Uint32(1), Mtu: ygot.Uint16(1500), Name: ygot.String("none"), Priority: ygot.Uint8(1)}},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			if got := tt.iface.GetBandwidthOrDefault(1); got != *tt.want.Bandwidth {
+				t.Errorf("GetBandwidthOrDefault() = %d, want %d", got, *tt.want.Bandwidth)
+			}
+			if got := tt.iface.GetMtuOrDefault(1500); got != *tt.want.Mtu {
+				t.Errorf("GetMtuOrDefault() = %d, want %d", got, *tt.want.Mtu)
+			}
+			if got := tt.iface.GetNameOrDefault("none"); got != *tt.want.Name {
+				t.Errorf("GetNameOrDefault() = %q, want %q", got, *tt.want.Name)
+			}
+			if got := tt.iface.GetPriorityOrDefault(1); got != *tt.want.Priority {
+				t.Errorf("GetPriorityOrDefault() = %d, want %d", got, *tt.want.Priority)
+			}
+		})
+	}
+}
+
+func TestGetters(t *testing.T) {
+	unset := &NetworkDevice_Interface{}
+	if unset.GetMtu() != 0 || unset.GetName() != "" || unset.GetPriority() != 0 || unset.GetBandwidth() != 0 {
+		t.Errorf("getters of an unset interface = %d, %q, %d, %d, want zero values", unset.GetMtu(), unset.GetName(), unset.GetPriority(), unset.GetBandwidth())
+	}
+	set := augmentDevice().GetInterface()
+	if set.GetMtu() != 1500 || set.GetName() != "eth0" || set.GetPriority() != 12 || set.GetBandwidth() != 1000 {
+		t.Errorf("getters of a set interface = %d, %q, %d, %d, want 1500, eth0, 12, 1000", set.GetMtu(), set.GetName(), set.GetPriority(), set.GetBandwidth())
+	}
+}
+
+func TestInPriorityRange(t *testing.T) {
+	for _, tt := range []struct {
+		v    uint8
+		want bool
+	}{
+		{0, false},
+		{1, true},
+		{5, true},
+		{6, false},
+		{9, false},
+		{10, true},
+		{15, true},
+		{16, false},
+	} {
+		if got := InPriorityRange(tt.v); got != tt.want {
+			t.Errorf("InPriorityRange(%d) = %t, want %t", tt.v, got, tt.want)
+		}
+		// The rule must agree with the generated validation.
+		d := augmentDevice()
+		d.Interface.Priority = ygot.Uint8(tt.v)
+		if err := d.Validate(); (err == nil) != tt.want {
+			t.Errorf("Validate(priority %d) error = %v, want valid %t", tt.v, err, tt.want)
+		}
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	d := augmentDevice()
+	if err := Shutdown(d, "eth0"); err != nil {
+		t.Fatalf("Shutdown(eth0) error = %v", err)
+	}
+	if got := d.Interface.GetEnabled(); got {
+		t.Errorf("Shutdown(eth0) enabled = %v, want false", got)
+	}
+	if got := d.Interface.GetStatus(); got != NetworkDevice_Interface_Status_down {
+		t.Errorf("Shutdown(eth0) status = %v, want down", got)
+	}
+
+	for _, name := range []string{"eth1", ""} {
+		if err := Shutdown(augmentDevice(), name); err == nil {
+			t.Errorf("Shutdown(%q) error = nil, want an error", name)
+		}
+	}
+	if err := Shutdown(&Device{}, "eth0"); err == nil {
+		t.Error("Shutdown(empty device) error = nil, want an error")
+	}
+
+	// A vlan-id is only valid on an enabled interface, so the device is
+	// left unchanged.
+	d = augmentDevice()
+	d.Interface.VlanId = ygot.Uint16(100)
+	if err := Shutdown(d, "eth0"); !errors.Is(err, ErrValidation) {
+		t.Errorf("Shutdown(eth0 with vlan-id) error = %v, want ErrValidation", err)
+	}
+	if d.Interface.Enabled != nil || d.Interface.GetStatus() != NetworkDevice_Interface_Status_up {
+		t.Errorf("Shutdown(eth0 with vlan-id) changed the device to enabled %v, status %v", d.Interface.GetEnabled(), d.Interface.GetStatus())
+	}
+}
+
+func TestSetStatus(t *testing.T) {
+	for _, tt := range []struct {
+		desc string
+		set  func(*NetworkDevice_Interface)
+		want NetworkDevice_Interface_Status_Union
+	}{
+		{"up", (*NetworkDevice_Interface).SetStatusUp, NetworkDevice_Interface_Status_up},
+		{"down", (*NetworkDevice_Interface).SetStatusDown, NetworkDevice_Interface_Status_down},
+		{"testing", (*NetworkDevice_Interface).SetStatusTesting, NetworkDevice_Interface_Status_testing},
+		{"dormant", (*NetworkDevice_Interface).SetStatusDormant, NetworkDevice_Interface_Status_dormant},
+		{"maintenance", func(i *NetworkDevice_Interface) { i.SetStatusMaintenance("scheduled") }, UnionString("maintenance-scheduled")},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			d := augmentDevice()
+			tt.set(d.Interface)
+			if got := d.Interface.GetStatus(); got != tt.want {
+				t.Errorf("status = %v, want %v", got, tt.want)
+			}
+			if err := ValidateDevice(d); err != nil {
+				t.Errorf("ValidateDevice() error = %v", err)
+			}
+
+			var back Device
+			if err := ParseDevice([]byte(emitRFC7951(t, d)), &back); err != nil {
+				t.Fatalf("ParseDevice() error = %v", err)
+			}
+			if got := back.GetInterface().GetStatus(); got != tt.want {
+				t.Errorf("status after round trip = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	d := augmentDevice()
+	d.Interface.Status = UnionString("scheduled")
+	if err := ValidateDevice(d); !errors.Is(err, ErrValidation) {
+		t.Errorf("ValidateDevice(status scheduled) error = %v, want ErrValidation", err)
+	}
+}
+
+func TestTags(t *testing.T) {
+	d := augmentDevice()
+	if _, ok := d.Interface.GetTag("env"); ok {
+		t.Errorf("GetTag(env) on an untagged interface = _, true, want false")
+	}
+
+	d.Interface.SetTag("env", "staging")
+	d.Interface.SetTag("team", "core")
+	d.Interface.SetTag("env", "prod")
+	if got, ok := d.Interface.GetTag("env"); !ok || got != "prod" {
+		t.Errorf("GetTag(env) = %q, %t, want prod, true", got, ok)
+	}
+	if got, want := d.Interface.TagMap(), map[string]string{"env": "prod", "team": "core"}; !maps.Equal(got, want) {
+		t.Errorf("TagMap() = %v, want %v", got, want)
+	}
+	if err := ValidateDevice(d); err != nil {
+		t.Errorf("ValidateDevice() error = %v", err)
+	}
+
+	var back Device
+	if err := ParseDevice([]byte(`{"interface": {"tags": {"tag": [{"key": "env", "value": "prod"}]}}}`), &back); err != nil {
+		t.Fatalf("ParseDevice() error = %v", err)
+	}
+	if got, ok := back.GetInterface().GetTag("env"); !ok || got != "prod" {
+		t.Errorf("GetTag(env) after ParseDevice = %q, %t, want prod, true", got, ok)
+	}
+}
@@ -0,0 +1,50 @@
+// Package internaltest holds helpers for the tests of this module, such as
+// the programs that emit device JSON.
+package internaltest
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"testing"
+
+	network "github.com/nleiva/go-yang-basics/pkg"
+)
+
+// Update is set by the -update flag of the test binary, which makes the tests
+// rewrite their golden files with what they got instead of comparing them.
+var Update = flag.Bool("update", false, "rewrite golden files with the output of the tests")
+
+// AssertJSON compares got, RFC7951 JSON device data, with the contents of the
+// golden file at goldenPath, after putting both in canonical form with
+// network.Canonicalize, so that formatting and member order do not matter. It
+// reports a difference, or data that cannot be read, as an error of t. With
+// -update, it writes the canonical form of got to goldenPath instead.
+func AssertJSON(t testing.TB, got []byte, goldenPath string) {
+	t.Helper()
+	canonical, err := network.Canonicalize(got)
+	if err != nil {
+		t.Errorf("cannot canonicalize JSON: %v\n%s", err, got)
+		return
+	}
+	if *Update {
+		if err := os.WriteFile(goldenPath, canonical, 0o644); err != nil {
+			t.Errorf("cannot update golden file: %v", err)
+		}
+		return
+	}
+
+	golden, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Errorf("cannot read golden file (run with -update to create it): %v", err)
+		return
+	}
+	want, err := network.Canonicalize(golden)
+	if err != nil {
+		t.Errorf("cannot canonicalize golden file %s: %v", goldenPath, err)
+		return
+	}
+	if !bytes.Equal(canonical, want) {
+		t.Errorf("JSON does not match golden file %s, got:\n%s\nwant:\n%s", goldenPath, canonical, want)
+	}
+}
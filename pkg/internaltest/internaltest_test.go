@@ -0,0 +1,83 @@
+package internaltest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// recorder is a testing.TB that records the errors reported to it instead of
+// failing the test.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertJSON(t *testing.T) {
+	dir := t.TempDir()
+	golden := filepath.Join(dir, "device.golden")
+	if err := os.WriteFile(golden, []byte(`{"interface": {"name": "eth0", "mtu": 1500}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		desc    string
+		got     string
+		path    string
+		wantErr string
+	}{
+		{desc: "match", got: "{\n  \"interface\": {\"mtu\": 1500,\n  \"name\": \"eth0\"}\n}", path: golden},
+		{desc: "mismatch", got: `{"interface": {"name": "eth0", "mtu": 9000}}`, path: golden, wantErr: "does not match golden file " + golden},
+		{desc: "invalid JSON", got: `{"interface": `, path: golden, wantErr: "cannot canonicalize JSON"},
+		{desc: "missing golden file", got: `{"interface": {"name": "eth0"}}`, path: filepath.Join(dir, "missing.golden"), wantErr: "-update"},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			r := &recorder{TB: t}
+			AssertJSON(r, []byte(tt.got), tt.path)
+			if tt.wantErr == "" {
+				if len(r.errors) > 0 {
+					t.Errorf("AssertJSON() errors = %q, want none", r.errors)
+				}
+				return
+			}
+			if len(r.errors) != 1 || !strings.Contains(r.errors[0], tt.wantErr) {
+				t.Errorf("AssertJSON() errors = %q, want one with %q", r.errors, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAssertJSONUpdate(t *testing.T) {
+	golden := filepath.Join(t.TempDir(), "device.golden")
+	got := []byte(`{"interface": {"name": "eth0", "mtu": 9000}}`)
+
+	defer func(old bool) { *Update = old }(*Update)
+	*Update = true
+	r := &recorder{TB: t}
+	AssertJSON(r, got, golden)
+	if len(r.errors) > 0 {
+		t.Fatalf("AssertJSON(-update) errors = %q, want none", r.errors)
+	}
+	data, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("AssertJSON(-update) did not write the golden file: %v", err)
+	}
+	want := "{\n   \"interface\": {\n      \"mtu\": 9000,\n      \"name\": \"eth0\"\n   }\n}\n"
+	if string(data) != want {
+		t.Errorf("golden file = %s, want the canonical form %s", data, want)
+	}
+
+	*Update = false
+	AssertJSON(r, got, golden)
+	if len(r.errors) > 0 {
+		t.Errorf("AssertJSON() after -update errors = %q, want none", r.errors)
+	}
+}
@@ -0,0 +1,90 @@
+package network
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/openconfig/goyang/pkg/yang"
+)
+
+// LegacyOpt is an option that modifies the behaviour of FromLegacyJSON.
+type LegacyOpt func(*legacyConfig)
+
+type legacyConfig struct {
+	keys map[string]string
+}
+
+// WithLegacyKeys maps flat keys to schema paths, e.g. "if_mtu" to
+// "/interface/mtu", in addition to the default mapping.
+func WithLegacyKeys(keys map[string]string) LegacyOpt {
+	return func(c *legacyConfig) {
+		for k, v := range keys {
+			c.keys[k] = v
+		}
+	}
+}
+
+// FromLegacyJSON unmarshals a flat JSON object, such as
+// {"interface_name": "eth0", "interface_mtu": 1500}, into d. By default, the
+// key of each leaf is its schema path with the slashes replaced by
+// underscores. A key that is not mapped to a path yields ErrUnknownField.
+func FromLegacyJSON(data []byte, d *Device, opts ...LegacyOpt) error {
+	cfg := &legacyConfig{keys: defaultLegacyKeys()}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var flat map[string]interface{}
+	if err := dec.Decode(&flat); err != nil {
+		return withPosition(data, err)
+	}
+
+	keys := make([]string, 0, len(flat))
+	for k := range flat {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	tree := map[string]interface{}{}
+	for _, k := range keys {
+		path, ok := cfg.keys[k]
+		if !ok {
+			return fmt.Errorf("legacy key %q: %w", k, ErrUnknownField)
+		}
+		elems := strings.Split(strings.Trim(path, "/"), "/")
+		node := tree
+		for _, e := range elems[:len(elems)-1] {
+			child, ok := node[e].(map[string]interface{})
+			if !ok {
+				child = map[string]interface{}{}
+				node[e] = child
+			}
+			node = child
+		}
+		node[elems[len(elems)-1]] = flat[k]
+	}
+
+	b, err := json.Marshal(tree)
+	if err != nil {
+		return fmt.Errorf("cannot encode schema JSON: %w", err)
+	}
+	return Parse(b, d)
+}
+
+// defaultLegacyKeys maps every leaf path of the schema, with the slashes
+// replaced by underscores, to the path. Leaves of lists are left out, since a
+// flat key cannot say which member it sets.
+func defaultLegacyKeys() map[string]string {
+	keys := map[string]string{}
+	walkSchema(SchemaTree["Device"], "", func(path string, e *yang.Entry) {
+		if (e.IsLeaf() || e.IsLeafList()) && !inList(e) {
+			keys[strings.ReplaceAll(strings.TrimPrefix(path, "/"), "/", "_")] = path
+		}
+	})
+	return keys
+}
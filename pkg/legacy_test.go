@@ -0,0 +1,38 @@
+package network
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFromLegacyJSON(t *testing.T) {
+	var d Device
+	if err := FromLegacyJSON([]byte(`{"interface_name": "eth0", "interface_mtu": 1500}`), &d); err != nil {
+		t.Fatalf("FromLegacyJSON() error = %v", err)
+	}
+	if d.GetInterface().GetName() != "eth0" || d.GetInterface().GetMtu() != 1500 {
+		t.Errorf("FromLegacyJSON() = %q, %d, want eth0, 1500", d.GetInterface().GetName(), d.GetInterface().GetMtu())
+	}
+	if err := ValidateDevice(&d); err != nil {
+		t.Errorf("ValidateDevice() error = %v, want nil", err)
+	}
+}
+
+func TestFromLegacyJSONKeys(t *testing.T) {
+	var d Device
+	err := FromLegacyJSON([]byte(`{"if_mtu": 9000}`), &d, WithLegacyKeys(map[string]string{"if_mtu": "/interface/mtu"}))
+	if err != nil {
+		t.Fatalf("FromLegacyJSON(WithLegacyKeys) error = %v", err)
+	}
+	if got := d.GetInterface().GetMtu(); got != 9000 {
+		t.Errorf("FromLegacyJSON(WithLegacyKeys) mtu = %d, want 9000", got)
+	}
+}
+
+func TestFromLegacyJSONUnknownKey(t *testing.T) {
+	var d Device
+	err := FromLegacyJSON([]byte(`{"interface_name": "eth0", "interface_colour": "blue"}`), &d)
+	if !errors.Is(err, ErrUnknownField) {
+		t.Errorf("FromLegacyJSON() error = %v, want ErrUnknownField", err)
+	}
+}
@@ -0,0 +1,38 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+// LoadAndMerge reads the RFC7951 JSON files at paths in order, unmarshals
+// each of them with Parse, and merges them into a single device in which the
+// leaves set by later files override those of earlier ones. Only the merged
+// device is validated, with ValidateDevice, so a file may hold a partial
+// configuration that only becomes valid once layered over the others.
+func LoadAndMerge(paths ...string) (*Device, error) {
+	if len(paths) == 0 {
+		return nil, errors.New("no files to load")
+	}
+	d := &Device{}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var layer Device
+		if err := Parse(data, &layer); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if err := ygot.MergeStructInto(d, &layer, &ygot.MergeOverwriteExistingFields{}); err != nil {
+			return nil, fmt.Errorf("cannot merge %s: %w", path, err)
+		}
+	}
+	if err := ValidateDevice(d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
@@ -0,0 +1,59 @@
+package network
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadAndMerge(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, data string) string {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	base := write("base.json", `{"interface": {"name": "eth0", "mtu": 1500, "priority": 3}}`)
+	override := write("override.json", `{"interface": {"mtu": 9000}}`)
+	invalid := write("invalid.json", `{"interface": {"priority": 7}}`)
+	broken := write("broken.json", `{"interface": `)
+
+	d, err := LoadAndMerge(base, override)
+	if err != nil {
+		t.Fatalf("LoadAndMerge(base, override) error = %v", err)
+	}
+	if got := d.GetInterface().GetMtu(); got != 9000 {
+		t.Errorf("LoadAndMerge(base, override) mtu = %d, want 9000", got)
+	}
+	if got := d.GetInterface().GetName(); got != "eth0" {
+		t.Errorf("LoadAndMerge(base, override) name = %q, want eth0", got)
+	}
+	if got := d.GetInterface().GetPriority(); got != 3 {
+		t.Errorf("LoadAndMerge(base, override) priority = %d, want 3", got)
+	}
+
+	d, err = LoadAndMerge(override, base)
+	if err != nil {
+		t.Fatalf("LoadAndMerge(override, base) error = %v", err)
+	}
+	if got := d.GetInterface().GetMtu(); got != 1500 {
+		t.Errorf("LoadAndMerge(override, base) mtu = %d, want 1500", got)
+	}
+
+	if _, err := LoadAndMerge(base, invalid); !errors.Is(err, ErrValidation) {
+		t.Errorf("LoadAndMerge(base, invalid) error = %v, want ErrValidation", err)
+	}
+	if _, err := LoadAndMerge(base, broken); err == nil {
+		t.Error("LoadAndMerge(base, broken) error = nil, want an error")
+	}
+	if _, err := LoadAndMerge(filepath.Join(dir, "missing.json")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("LoadAndMerge(missing) error = %v, want os.ErrNotExist", err)
+	}
+	if _, err := LoadAndMerge(); err == nil {
+		t.Error("LoadAndMerge() error = nil, want an error")
+	}
+}
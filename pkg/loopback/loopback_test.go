@@ -0,0 +1,36 @@
+package loopback
+
+import (
+	"testing"
+
+	network "github.com/nleiva/go-yang-basics/pkg"
+	"github.com/openconfig/ygot/ygot"
+)
+
+func TestLoopbackName(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		wantLoopback bool
+		wantNetwork  bool
+	}{
+		{"eth0", true, true},
+		{"wlan1", true, true},
+		{"lo0", true, false},
+		{"lo", false, false},
+		{"xlo0", false, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			lo := &Device{}
+			lo.GetOrCreateInterface().Name = ygot.String(tt.name)
+			if err := lo.Validate(); (err == nil) != tt.wantLoopback {
+				t.Errorf("loopback Validate() error = %v, want valid %v", err, tt.wantLoopback)
+			}
+
+			d := &network.Device{}
+			d.GetOrCreateInterface().Name = ygot.String(tt.name)
+			if err := d.Validate(); (err == nil) != tt.wantNetwork {
+				t.Errorf("network Validate() error = %v, want valid %v", err, tt.wantNetwork)
+			}
+		})
+	}
+}
@@ -0,0 +1,1605 @@
+/*
+Package loopback is a generated package which contains definitions
+of structs which represent a YANG schema. The generated schema can be
+compressed by a series of transformations (compression was false
+in this case).
+
+This package was generated by /Users/nleiva/go/pkg/mod/github.com/openconfig/ygot@v0.32.0/genutil/names.go
+using the following YANG input files:
+  - base.yang
+  - loopback.yang
+  - augment.yang
+
+Imported modules were sourced from:
+  - ...
+*/
+package loopback
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+)
+
+// Binary is a type that is used for fields that have a YANG type of
+// binary. It is used such that binary fields can be distinguished from
+// leaf-lists of uint8s (which are mapped to []uint8, equivalent to
+// []byte in reflection).
+type Binary []byte
+
+// YANGEmpty is a type that is used for fields that have a YANG type of
+// empty. It is used such that empty fields can be distinguished from boolean fields
+// in the generated code.
+type YANGEmpty bool
+
+// UnionInt8 is an int8 type assignable to unions of which it is a subtype.
+type UnionInt8 int8
+
+// UnionInt16 is an int16 type assignable to unions of which it is a subtype.
+type UnionInt16 int16
+
+// UnionInt32 is an int32 type assignable to unions of which it is a subtype.
+type UnionInt32 int32
+
+// UnionInt64 is an int64 type assignable to unions of which it is a subtype.
+type UnionInt64 int64
+
+// UnionUint8 is a uint8 type assignable to unions of which it is a subtype.
+type UnionUint8 uint8
+
+// UnionUint16 is a uint16 type assignable to unions of which it is a subtype.
+type UnionUint16 uint16
+
+// UnionUint32 is a uint32 type assignable to unions of which it is a subtype.
+type UnionUint32 uint32
+
+// UnionUint64 is a uint64 type assignable to unions of which it is a subtype.
+type UnionUint64 uint64
+
+// UnionFloat64 is a float64 type assignable to unions of which it is a subtype.
+type UnionFloat64 float64
+
+// UnionString is a string type assignable to unions of which it is a subtype.
+type UnionString string
+
+// UnionBool is a bool type assignable to unions of which it is a subtype.
+type UnionBool bool
+
+// UnionUnsupported is an interface{} wrapper type for unsupported types. It is
+// assignable to unions of which it is a subtype.
+type UnionUnsupported struct {
+	Value interface{}
+}
+
+var (
+	SchemaTree map[string]*yang.Entry
+	ΛEnumTypes map[string][]reflect.Type
+)
+
+func init() {
+	var err error
+	initΛEnumTypes()
+	if SchemaTree, err = UnzipSchema(); err != nil {
+		panic("schema error: " + err.Error())
+	}
+}
+
+// Schema returns the details of the generated schema.
+func Schema() (*ytypes.Schema, error) {
+	uzp, err := UnzipSchema()
+	if err != nil {
+		return nil, fmt.Errorf("cannot unzip schema, %v", err)
+	}
+
+	return &ytypes.Schema{
+		Root:       &Device{},
+		SchemaTree: uzp,
+		Unmarshal:  Unmarshal,
+	}, nil
+}
+
+// UnzipSchema unzips the zipped schema and returns a map of yang.Entry nodes,
+// keyed by the name of the struct that the yang.Entry describes the schema for.
+func UnzipSchema() (map[string]*yang.Entry, error) {
+	var schemaTree map[string]*yang.Entry
+	var err error
+	if schemaTree, err = ygot.GzipToSchema(ySchema); err != nil {
+		return nil, fmt.Errorf("could not unzip the schema; %v", err)
+	}
+	return schemaTree, nil
+}
+
+// Unmarshal unmarshals data, which must be RFC7951 JSON format, into
+// destStruct, which must be non-nil and the correct GoStruct type. It returns
+// an error if the destStruct is not found in the schema or the data cannot be
+// unmarshaled. The supplied options (opts) are used to control the behaviour
+// of the unmarshal function - for example, determining whether errors are
+// thrown for unknown fields in the input JSON.
+func Unmarshal(data []byte, destStruct ygot.GoStruct, opts ...ytypes.UnmarshalOpt) error {
+	tn := reflect.TypeOf(destStruct).Elem().Name()
+	schema, ok := SchemaTree[tn]
+	if !ok {
+		return fmt.Errorf("could not find schema for type %s", tn)
+	}
+	var jsonTree interface{}
+	if err := json.Unmarshal([]byte(data), &jsonTree); err != nil {
+		return err
+	}
+	return ytypes.Unmarshal(schema, destStruct, jsonTree, opts...)
+}
+
+// Device represents the /device YANG schema element.
+type Device struct {
+	Interface *NetworkDevice_Interface `path:"interface" module:"network-device"`
+	System    *NetworkDevice_System    `path:"system" module:"network-device"`
+}
+
+// IsYANGGoStruct ensures that Device implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Device) IsYANGGoStruct() {}
+
+// GetOrCreateInterface retrieves the value of the Interface field
+// or returns the existing field if it already exists.
+func (t *Device) GetOrCreateInterface() *NetworkDevice_Interface {
+	if t.Interface != nil {
+		return t.Interface
+	}
+	t.Interface = &NetworkDevice_Interface{}
+	return t.Interface
+}
+
+// GetOrCreateSystem retrieves the value of the System field
+// or returns the existing field if it already exists.
+func (t *Device) GetOrCreateSystem() *NetworkDevice_System {
+	if t.System != nil {
+		return t.System
+	}
+	t.System = &NetworkDevice_System{}
+	return t.System
+}
+
+// GetInterface returns the value of the Interface struct pointer
+// from Device. If the receiver or the field Interface is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Device) GetInterface() *NetworkDevice_Interface {
+	if t != nil && t.Interface != nil {
+		return t.Interface
+	}
+	return nil
+}
+
+// GetSystem returns the value of the System struct pointer
+// from Device. If the receiver or the field System is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Device) GetSystem() *NetworkDevice_System {
+	if t != nil && t.System != nil {
+		return t.System
+	}
+	return nil
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Device
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Device) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	t.Interface.PopulateDefaults()
+	t.System.PopulateDefaults()
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Device) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Device"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Device) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Device) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Device.
+func (*Device) ΛBelongingModule() string {
+	return ""
+}
+
+// NetworkDevice_Interface represents the /network-device/interface YANG schema element.
+type NetworkDevice_Interface struct {
+	AutoNegotiate *bool                                `path:"auto-negotiate" module:"network-device"`
+	Bandwidth     *uint32                              `path:"bandwidth" module:"network-device-extensions"`
+	Cookie        Binary                               `path:"cookie" module:"network-device"`
+	Description   *string                              `path:"description" module:"network-device"`
+	Dhcp          *bool                                `path:"dhcp" module:"network-device"`
+	Duplex        E_NetworkDevice_Interface_Duplex     `path:"duplex" module:"network-device"`
+	Enabled       *bool                                `path:"enabled" module:"network-device"`
+	InterfaceType E_NetworkDevice_InterfaceType        `path:"interface-type" module:"network-device"`
+	Ipv4Address   []string                             `path:"ipv4-address" module:"network-device"`
+	IsManagement  YANGEmpty                            `path:"is-management" module:"network-device"`
+	LoadFactor    *float64                             `path:"load-factor" module:"network-device"`
+	MacAddress    *string                              `path:"mac-address" module:"network-device"`
+	Mtu           *uint16                              `path:"mtu" module:"network-device"`
+	Name          *string                              `path:"name" module:"network-device"`
+	Password      *string                              `path:"password" module:"network-device"`
+	Priority      *uint8                               `path:"priority" module:"network-device"`
+	Speed         E_NetworkDevice_Interface_Speed      `path:"speed" module:"network-device"`
+	State         *NetworkDevice_Interface_State       `path:"state" module:"network-device"`
+	Status        NetworkDevice_Interface_Status_Union `path:"status" module:"network-device-extensions"`
+	Tags          *NetworkDevice_Interface_Tags        `path:"tags" module:"network-device"`
+	Tunnel        *NetworkDevice_Interface_Tunnel      `path:"tunnel" module:"network-device" yangPresence:"true"`
+	VlanId        *uint16                              `path:"vlan-id" module:"network-device"`
+}
+
+// IsYANGGoStruct ensures that NetworkDevice_Interface implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*NetworkDevice_Interface) IsYANGGoStruct() {}
+
+// GetOrCreateState retrieves the value of the State field
+// or returns the existing field if it already exists.
+func (t *NetworkDevice_Interface) GetOrCreateState() *NetworkDevice_Interface_State {
+	if t.State != nil {
+		return t.State
+	}
+	t.State = &NetworkDevice_Interface_State{}
+	return t.State
+}
+
+// GetOrCreateTags retrieves the value of the Tags field
+// or returns the existing field if it already exists.
+func (t *NetworkDevice_Interface) GetOrCreateTags() *NetworkDevice_Interface_Tags {
+	if t.Tags != nil {
+		return t.Tags
+	}
+	t.Tags = &NetworkDevice_Interface_Tags{}
+	return t.Tags
+}
+
+// GetOrCreateTunnel retrieves the value of the Tunnel field
+// or returns the existing field if it already exists.
+func (t *NetworkDevice_Interface) GetOrCreateTunnel() *NetworkDevice_Interface_Tunnel {
+	if t.Tunnel != nil {
+		return t.Tunnel
+	}
+	t.Tunnel = &NetworkDevice_Interface_Tunnel{}
+	return t.Tunnel
+}
+
+// GetState returns the value of the State struct pointer
+// from NetworkDevice_Interface. If the receiver or the field State is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *NetworkDevice_Interface) GetState() *NetworkDevice_Interface_State {
+	if t != nil && t.State != nil {
+		return t.State
+	}
+	return nil
+}
+
+// GetTags returns the value of the Tags struct pointer
+// from NetworkDevice_Interface. If the receiver or the field Tags is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *NetworkDevice_Interface) GetTags() *NetworkDevice_Interface_Tags {
+	if t != nil && t.Tags != nil {
+		return t.Tags
+	}
+	return nil
+}
+
+// GetTunnel returns the value of the Tunnel struct pointer
+// from NetworkDevice_Interface. If the receiver or the field Tunnel is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *NetworkDevice_Interface) GetTunnel() *NetworkDevice_Interface_Tunnel {
+	if t != nil && t.Tunnel != nil {
+		return t.Tunnel
+	}
+	return nil
+}
+
+// GetAutoNegotiate retrieves the value of the leaf AutoNegotiate from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if AutoNegotiate is set, it can
+// safely use t.GetAutoNegotiate() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.AutoNegotiate == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetAutoNegotiate() bool {
+	if t == nil || t.AutoNegotiate == nil {
+		return false
+	}
+	return *t.AutoNegotiate
+}
+
+// GetBandwidth retrieves the value of the leaf Bandwidth from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Bandwidth is set, it can
+// safely use t.GetBandwidth() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Bandwidth == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetBandwidth() uint32 {
+	if t == nil || t.Bandwidth == nil {
+		return 0
+	}
+	return *t.Bandwidth
+}
+
+// GetCookie retrieves the value of the leaf Cookie from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Cookie is set, it can
+// safely use t.GetCookie() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Cookie == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetCookie() Binary {
+	if t == nil || t.Cookie == nil {
+		return nil
+	}
+	return t.Cookie
+}
+
+// GetDescription retrieves the value of the leaf Description from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Description is set, it can
+// safely use t.GetDescription() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Description == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetDescription() string {
+	if t == nil || t.Description == nil {
+		return ""
+	}
+	return *t.Description
+}
+
+// GetDhcp retrieves the value of the leaf Dhcp from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Dhcp is set, it can
+// safely use t.GetDhcp() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Dhcp == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetDhcp() bool {
+	if t == nil || t.Dhcp == nil {
+		return false
+	}
+	return *t.Dhcp
+}
+
+// GetDuplex retrieves the value of the leaf Duplex from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Duplex is set, it can
+// safely use t.GetDuplex() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Duplex == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetDuplex() E_NetworkDevice_Interface_Duplex {
+	if t == nil || t.Duplex == 0 {
+		return 0
+	}
+	return t.Duplex
+}
+
+// GetEnabled retrieves the value of the leaf Enabled from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Enabled is set, it can
+// safely use t.GetEnabled() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Enabled == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return true
+	}
+	return *t.Enabled
+}
+
+// GetInterfaceType retrieves the value of the leaf InterfaceType from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InterfaceType is set, it can
+// safely use t.GetInterfaceType() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InterfaceType == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetInterfaceType() E_NetworkDevice_InterfaceType {
+	if t == nil || t.InterfaceType == 0 {
+		return 0
+	}
+	return t.InterfaceType
+}
+
+// GetIpv4Address retrieves the value of the leaf Ipv4Address from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Ipv4Address is set, it can
+// safely use t.GetIpv4Address() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Ipv4Address == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetIpv4Address() []string {
+	if t == nil || t.Ipv4Address == nil {
+		return nil
+	}
+	return t.Ipv4Address
+}
+
+// GetIsManagement retrieves the value of the leaf IsManagement from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if IsManagement is set, it can
+// safely use t.GetIsManagement() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.IsManagement == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetIsManagement() YANGEmpty {
+	if t == nil || t.IsManagement == false {
+		return false
+	}
+	return t.IsManagement
+}
+
+// GetLoadFactor retrieves the value of the leaf LoadFactor from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if LoadFactor is set, it can
+// safely use t.GetLoadFactor() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.LoadFactor == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetLoadFactor() float64 {
+	if t == nil || t.LoadFactor == nil {
+		return 0.0
+	}
+	return *t.LoadFactor
+}
+
+// GetMacAddress retrieves the value of the leaf MacAddress from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if MacAddress is set, it can
+// safely use t.GetMacAddress() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.MacAddress == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetMacAddress() string {
+	if t == nil || t.MacAddress == nil {
+		return ""
+	}
+	return *t.MacAddress
+}
+
+// GetMtu retrieves the value of the leaf Mtu from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Mtu is set, it can
+// safely use t.GetMtu() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Mtu == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetMtu() uint16 {
+	if t == nil || t.Mtu == nil {
+		return 0
+	}
+	return *t.Mtu
+}
+
+// GetName retrieves the value of the leaf Name from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Name is set, it can
+// safely use t.GetName() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Name == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetName() string {
+	if t == nil || t.Name == nil {
+		return ""
+	}
+	return *t.Name
+}
+
+// GetPassword retrieves the value of the leaf Password from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Password is set, it can
+// safely use t.GetPassword() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Password == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetPassword() string {
+	if t == nil || t.Password == nil {
+		return ""
+	}
+	return *t.Password
+}
+
+// GetPriority retrieves the value of the leaf Priority from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Priority is set, it can
+// safely use t.GetPriority() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Priority == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetPriority() uint8 {
+	if t == nil || t.Priority == nil {
+		return 0
+	}
+	return *t.Priority
+}
+
+// GetSpeed retrieves the value of the leaf Speed from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Speed is set, it can
+// safely use t.GetSpeed() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Speed == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetSpeed() E_NetworkDevice_Interface_Speed {
+	if t == nil || t.Speed == 0 {
+		return 0
+	}
+	return t.Speed
+}
+
+// GetStatus retrieves the value of the leaf Status from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Status is set, it can
+// safely use t.GetStatus() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Status == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetStatus() NetworkDevice_Interface_Status_Union {
+	if t == nil || t.Status == nil {
+		return nil
+	}
+	return t.Status
+}
+
+// GetVlanId retrieves the value of the leaf VlanId from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if VlanId is set, it can
+// safely use t.GetVlanId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.VlanId == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetVlanId() uint16 {
+	if t == nil || t.VlanId == nil {
+		return 0
+	}
+	return *t.VlanId
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the NetworkDevice_Interface
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *NetworkDevice_Interface) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.Enabled == nil {
+		var v bool = true
+		t.Enabled = &v
+	}
+	t.State.PopulateDefaults()
+	t.Tags.PopulateDefaults()
+	t.Tunnel.PopulateDefaults()
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_Interface) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["NetworkDevice_Interface"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_Interface) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *NetworkDevice_Interface) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of NetworkDevice_Interface.
+func (*NetworkDevice_Interface) ΛBelongingModule() string {
+	return "network-device"
+}
+
+// NetworkDevice_Interface_Status_Union is an interface that is implemented by valid types for the union
+// for the leaf /network-device/interface/status within the YANG schema.
+// Union type can be one of [E_NetworkDevice_Interface_Status, UnionString].
+type NetworkDevice_Interface_Status_Union interface {
+	// Union type can be one of [E_NetworkDevice_Interface_Status, UnionString]
+	Documentation_for_NetworkDevice_Interface_Status_Union()
+}
+
+// Documentation_for_NetworkDevice_Interface_Status_Union ensures that E_NetworkDevice_Interface_Status
+// implements the NetworkDevice_Interface_Status_Union interface.
+func (E_NetworkDevice_Interface_Status) Documentation_for_NetworkDevice_Interface_Status_Union() {}
+
+// Documentation_for_NetworkDevice_Interface_Status_Union ensures that UnionString
+// implements the NetworkDevice_Interface_Status_Union interface.
+func (UnionString) Documentation_for_NetworkDevice_Interface_Status_Union() {}
+
+// To_NetworkDevice_Interface_Status_Union takes an input interface{} and attempts to convert it to a struct
+// which implements the NetworkDevice_Interface_Status_Union union. It returns an error if the interface{} supplied
+// cannot be converted to a type within the union.
+func (t *NetworkDevice_Interface) To_NetworkDevice_Interface_Status_Union(i interface{}) (NetworkDevice_Interface_Status_Union, error) {
+	if v, ok := i.(NetworkDevice_Interface_Status_Union); ok {
+		return v, nil
+	}
+	switch v := i.(type) {
+	case string:
+		return UnionString(v), nil
+	}
+	return nil, fmt.Errorf("cannot convert %v to NetworkDevice_Interface_Status_Union, unknown union type, got: %T, want any of [E_NetworkDevice_Interface_Status, string]", i, i)
+}
+
+// NetworkDevice_Interface_State represents the /network-device/interface/state YANG schema element.
+type NetworkDevice_Interface_State struct {
+	Counters *NetworkDevice_Interface_State_Counters `path:"counters" module:"network-device"`
+	JumboMtu *uint16                                 `path:"jumbo-mtu" module:"network-device"`
+}
+
+// IsYANGGoStruct ensures that NetworkDevice_Interface_State implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*NetworkDevice_Interface_State) IsYANGGoStruct() {}
+
+// GetOrCreateCounters retrieves the value of the Counters field
+// or returns the existing field if it already exists.
+func (t *NetworkDevice_Interface_State) GetOrCreateCounters() *NetworkDevice_Interface_State_Counters {
+	if t.Counters != nil {
+		return t.Counters
+	}
+	t.Counters = &NetworkDevice_Interface_State_Counters{}
+	return t.Counters
+}
+
+// GetCounters returns the value of the Counters struct pointer
+// from NetworkDevice_Interface_State. If the receiver or the field Counters is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *NetworkDevice_Interface_State) GetCounters() *NetworkDevice_Interface_State_Counters {
+	if t != nil && t.Counters != nil {
+		return t.Counters
+	}
+	return nil
+}
+
+// GetJumboMtu retrieves the value of the leaf JumboMtu from the NetworkDevice_Interface_State
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if JumboMtu is set, it can
+// safely use t.GetJumboMtu() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.JumboMtu == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface_State) GetJumboMtu() uint16 {
+	if t == nil || t.JumboMtu == nil {
+		return 0
+	}
+	return *t.JumboMtu
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the NetworkDevice_Interface_State
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *NetworkDevice_Interface_State) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	t.Counters.PopulateDefaults()
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_Interface_State) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["NetworkDevice_Interface_State"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_Interface_State) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *NetworkDevice_Interface_State) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of NetworkDevice_Interface_State.
+func (*NetworkDevice_Interface_State) ΛBelongingModule() string {
+	return "network-device"
+}
+
+// NetworkDevice_Interface_State_Counters represents the /network-device/interface/state/counters YANG schema element.
+type NetworkDevice_Interface_State_Counters struct {
+	InOctets  *uint64 `path:"in-octets" module:"network-device"`
+	OutOctets *uint64 `path:"out-octets" module:"network-device"`
+}
+
+// IsYANGGoStruct ensures that NetworkDevice_Interface_State_Counters implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*NetworkDevice_Interface_State_Counters) IsYANGGoStruct() {}
+
+// GetInOctets retrieves the value of the leaf InOctets from the NetworkDevice_Interface_State_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InOctets is set, it can
+// safely use t.GetInOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InOctets == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface_State_Counters) GetInOctets() uint64 {
+	if t == nil || t.InOctets == nil {
+		return 0
+	}
+	return *t.InOctets
+}
+
+// GetOutOctets retrieves the value of the leaf OutOctets from the NetworkDevice_Interface_State_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutOctets is set, it can
+// safely use t.GetOutOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutOctets == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface_State_Counters) GetOutOctets() uint64 {
+	if t == nil || t.OutOctets == nil {
+		return 0
+	}
+	return *t.OutOctets
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the NetworkDevice_Interface_State_Counters
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *NetworkDevice_Interface_State_Counters) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_Interface_State_Counters) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["NetworkDevice_Interface_State_Counters"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_Interface_State_Counters) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *NetworkDevice_Interface_State_Counters) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of NetworkDevice_Interface_State_Counters.
+func (*NetworkDevice_Interface_State_Counters) ΛBelongingModule() string {
+	return "network-device"
+}
+
+// NetworkDevice_Interface_Tags represents the /network-device/interface/tags YANG schema element.
+type NetworkDevice_Interface_Tags struct {
+	Tag map[string]*NetworkDevice_Interface_Tags_Tag `path:"tag" module:"network-device"`
+}
+
+// IsYANGGoStruct ensures that NetworkDevice_Interface_Tags implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*NetworkDevice_Interface_Tags) IsYANGGoStruct() {}
+
+// NewTag creates a new entry in the Tag list of the
+// NetworkDevice_Interface_Tags struct. The keys of the list are populated from the input
+// arguments.
+func (t *NetworkDevice_Interface_Tags) NewTag(Key string) (*NetworkDevice_Interface_Tags_Tag, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Tag == nil {
+		t.Tag = make(map[string]*NetworkDevice_Interface_Tags_Tag)
+	}
+
+	key := Key
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Tag[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Tag", key)
+	}
+
+	t.Tag[key] = &NetworkDevice_Interface_Tags_Tag{
+		Key: &Key,
+	}
+
+	return t.Tag[key], nil
+}
+
+// GetOrCreateTagMap returns the list (map) from NetworkDevice_Interface_Tags.
+//
+// It initializes the field if not already initialized.
+func (t *NetworkDevice_Interface_Tags) GetOrCreateTagMap() map[string]*NetworkDevice_Interface_Tags_Tag {
+	if t.Tag == nil {
+		t.Tag = make(map[string]*NetworkDevice_Interface_Tags_Tag)
+	}
+	return t.Tag
+}
+
+// GetOrCreateTag retrieves the value with the specified keys from
+// the receiver NetworkDevice_Interface_Tags. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *NetworkDevice_Interface_Tags) GetOrCreateTag(Key string) *NetworkDevice_Interface_Tags_Tag {
+
+	key := Key
+
+	if v, ok := t.Tag[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewTag(Key)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateTag got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetTag retrieves the value with the specified key from
+// the Tag map field of NetworkDevice_Interface_Tags. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *NetworkDevice_Interface_Tags) GetTag(Key string) *NetworkDevice_Interface_Tags_Tag {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Key
+
+	if lm, ok := t.Tag[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the NetworkDevice_Interface_Tags
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *NetworkDevice_Interface_Tags) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	for _, e := range t.Tag {
+		e.PopulateDefaults()
+	}
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_Interface_Tags) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["NetworkDevice_Interface_Tags"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_Interface_Tags) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *NetworkDevice_Interface_Tags) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of NetworkDevice_Interface_Tags.
+func (*NetworkDevice_Interface_Tags) ΛBelongingModule() string {
+	return "network-device"
+}
+
+// NetworkDevice_Interface_Tags_Tag represents the /network-device/interface/tags/tag YANG schema element.
+type NetworkDevice_Interface_Tags_Tag struct {
+	Key   *string `path:"key" module:"network-device"`
+	Value *string `path:"value" module:"network-device"`
+}
+
+// IsYANGGoStruct ensures that NetworkDevice_Interface_Tags_Tag implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*NetworkDevice_Interface_Tags_Tag) IsYANGGoStruct() {}
+
+// GetKey retrieves the value of the leaf Key from the NetworkDevice_Interface_Tags_Tag
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Key is set, it can
+// safely use t.GetKey() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Key == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface_Tags_Tag) GetKey() string {
+	if t == nil || t.Key == nil {
+		return ""
+	}
+	return *t.Key
+}
+
+// GetValue retrieves the value of the leaf Value from the NetworkDevice_Interface_Tags_Tag
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Value is set, it can
+// safely use t.GetValue() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Value == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface_Tags_Tag) GetValue() string {
+	if t == nil || t.Value == nil {
+		return ""
+	}
+	return *t.Value
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the NetworkDevice_Interface_Tags_Tag
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *NetworkDevice_Interface_Tags_Tag) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛListKeyMap returns the keys of the NetworkDevice_Interface_Tags_Tag struct, which is a YANG list entry.
+func (t *NetworkDevice_Interface_Tags_Tag) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Key == nil {
+		return nil, fmt.Errorf("nil value for key Key")
+	}
+
+	return map[string]interface{}{
+		"key": *t.Key,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_Interface_Tags_Tag) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["NetworkDevice_Interface_Tags_Tag"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_Interface_Tags_Tag) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *NetworkDevice_Interface_Tags_Tag) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of NetworkDevice_Interface_Tags_Tag.
+func (*NetworkDevice_Interface_Tags_Tag) ΛBelongingModule() string {
+	return "network-device"
+}
+
+// NetworkDevice_Interface_Tunnel represents the /network-device/interface/tunnel YANG schema element.
+type NetworkDevice_Interface_Tunnel struct {
+	RemoteAddress *string `path:"remote-address" module:"network-device"`
+}
+
+// IsYANGGoStruct ensures that NetworkDevice_Interface_Tunnel implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*NetworkDevice_Interface_Tunnel) IsYANGGoStruct() {}
+
+// GetRemoteAddress retrieves the value of the leaf RemoteAddress from the NetworkDevice_Interface_Tunnel
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if RemoteAddress is set, it can
+// safely use t.GetRemoteAddress() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.RemoteAddress == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface_Tunnel) GetRemoteAddress() string {
+	if t == nil || t.RemoteAddress == nil {
+		return ""
+	}
+	return *t.RemoteAddress
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the NetworkDevice_Interface_Tunnel
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *NetworkDevice_Interface_Tunnel) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_Interface_Tunnel) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["NetworkDevice_Interface_Tunnel"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_Interface_Tunnel) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *NetworkDevice_Interface_Tunnel) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of NetworkDevice_Interface_Tunnel.
+func (*NetworkDevice_Interface_Tunnel) ΛBelongingModule() string {
+	return "network-device"
+}
+
+// NetworkDevice_System represents the /network-device/system YANG schema element.
+type NetworkDevice_System struct {
+	DefaultMtu *uint16  `path:"default-mtu" module:"network-device"`
+	DnsServer  []string `path:"dns-server" module:"network-device"`
+	NtpServer  []string `path:"ntp-server" module:"network-device"`
+}
+
+// IsYANGGoStruct ensures that NetworkDevice_System implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*NetworkDevice_System) IsYANGGoStruct() {}
+
+// GetDefaultMtu retrieves the value of the leaf DefaultMtu from the NetworkDevice_System
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if DefaultMtu is set, it can
+// safely use t.GetDefaultMtu() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.DefaultMtu == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_System) GetDefaultMtu() uint16 {
+	if t == nil || t.DefaultMtu == nil {
+		return 0
+	}
+	return *t.DefaultMtu
+}
+
+// GetDnsServer retrieves the value of the leaf DnsServer from the NetworkDevice_System
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if DnsServer is set, it can
+// safely use t.GetDnsServer() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.DnsServer == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_System) GetDnsServer() []string {
+	if t == nil || t.DnsServer == nil {
+		return nil
+	}
+	return t.DnsServer
+}
+
+// GetNtpServer retrieves the value of the leaf NtpServer from the NetworkDevice_System
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if NtpServer is set, it can
+// safely use t.GetNtpServer() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.NtpServer == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_System) GetNtpServer() []string {
+	if t == nil || t.NtpServer == nil {
+		return nil
+	}
+	return t.NtpServer
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the NetworkDevice_System
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *NetworkDevice_System) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_System) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["NetworkDevice_System"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_System) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *NetworkDevice_System) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of NetworkDevice_System.
+func (*NetworkDevice_System) ΛBelongingModule() string {
+	return "network-device"
+}
+
+// E_NetworkDevice_InterfaceType is a derived int64 type which is used to represent
+// the enumerated node NetworkDevice_InterfaceType. An additional value named
+// NetworkDevice_InterfaceType_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_NetworkDevice_InterfaceType int64
+
+// IsYANGGoEnum ensures that NetworkDevice_InterfaceType implements the yang.GoEnum
+// interface. This ensures that NetworkDevice_InterfaceType can be identified as a
+// mapped type for a YANG enumeration.
+func (E_NetworkDevice_InterfaceType) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  NetworkDevice_InterfaceType.
+func (E_NetworkDevice_InterfaceType) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+// String returns a logging-friendly string for E_NetworkDevice_InterfaceType.
+func (e E_NetworkDevice_InterfaceType) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_NetworkDevice_InterfaceType")
+}
+
+const (
+	// NetworkDevice_InterfaceType_UNSET corresponds to the value UNSET of NetworkDevice_InterfaceType
+	NetworkDevice_InterfaceType_UNSET E_NetworkDevice_InterfaceType = 0
+	// NetworkDevice_InterfaceType_ethernet corresponds to the value ethernet of NetworkDevice_InterfaceType
+	NetworkDevice_InterfaceType_ethernet E_NetworkDevice_InterfaceType = 1
+	// NetworkDevice_InterfaceType_wifi corresponds to the value wifi of NetworkDevice_InterfaceType
+	NetworkDevice_InterfaceType_wifi E_NetworkDevice_InterfaceType = 2
+)
+
+// E_NetworkDevice_Interface_Duplex is a derived int64 type which is used to represent
+// the enumerated node NetworkDevice_Interface_Duplex. An additional value named
+// NetworkDevice_Interface_Duplex_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_NetworkDevice_Interface_Duplex int64
+
+// IsYANGGoEnum ensures that NetworkDevice_Interface_Duplex implements the yang.GoEnum
+// interface. This ensures that NetworkDevice_Interface_Duplex can be identified as a
+// mapped type for a YANG enumeration.
+func (E_NetworkDevice_Interface_Duplex) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  NetworkDevice_Interface_Duplex.
+func (E_NetworkDevice_Interface_Duplex) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_NetworkDevice_Interface_Duplex.
+func (e E_NetworkDevice_Interface_Duplex) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_NetworkDevice_Interface_Duplex")
+}
+
+const (
+	// NetworkDevice_Interface_Duplex_UNSET corresponds to the value UNSET of NetworkDevice_Interface_Duplex
+	NetworkDevice_Interface_Duplex_UNSET E_NetworkDevice_Interface_Duplex = 0
+	// NetworkDevice_Interface_Duplex_half corresponds to the value half of NetworkDevice_Interface_Duplex
+	NetworkDevice_Interface_Duplex_half E_NetworkDevice_Interface_Duplex = 1
+	// NetworkDevice_Interface_Duplex_full corresponds to the value full of NetworkDevice_Interface_Duplex
+	NetworkDevice_Interface_Duplex_full E_NetworkDevice_Interface_Duplex = 2
+)
+
+// E_NetworkDevice_Interface_Speed is a derived int64 type which is used to represent
+// the enumerated node NetworkDevice_Interface_Speed. An additional value named
+// NetworkDevice_Interface_Speed_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_NetworkDevice_Interface_Speed int64
+
+// IsYANGGoEnum ensures that NetworkDevice_Interface_Speed implements the yang.GoEnum
+// interface. This ensures that NetworkDevice_Interface_Speed can be identified as a
+// mapped type for a YANG enumeration.
+func (E_NetworkDevice_Interface_Speed) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  NetworkDevice_Interface_Speed.
+func (E_NetworkDevice_Interface_Speed) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_NetworkDevice_Interface_Speed.
+func (e E_NetworkDevice_Interface_Speed) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_NetworkDevice_Interface_Speed")
+}
+
+const (
+	// NetworkDevice_Interface_Speed_UNSET corresponds to the value UNSET of NetworkDevice_Interface_Speed
+	NetworkDevice_Interface_Speed_UNSET E_NetworkDevice_Interface_Speed = 0
+	// NetworkDevice_Interface_Speed_10M corresponds to the value 10M of NetworkDevice_Interface_Speed
+	NetworkDevice_Interface_Speed_10M E_NetworkDevice_Interface_Speed = 1
+	// NetworkDevice_Interface_Speed_100M corresponds to the value 100M of NetworkDevice_Interface_Speed
+	NetworkDevice_Interface_Speed_100M E_NetworkDevice_Interface_Speed = 2
+	// NetworkDevice_Interface_Speed_1G corresponds to the value 1G of NetworkDevice_Interface_Speed
+	NetworkDevice_Interface_Speed_1G E_NetworkDevice_Interface_Speed = 3
+	// NetworkDevice_Interface_Speed_10G corresponds to the value 10G of NetworkDevice_Interface_Speed
+	NetworkDevice_Interface_Speed_10G E_NetworkDevice_Interface_Speed = 4
+)
+
+// E_NetworkDevice_Interface_Status is a derived int64 type which is used to represent
+// the enumerated node NetworkDevice_Interface_Status. An additional value named
+// NetworkDevice_Interface_Status_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_NetworkDevice_Interface_Status int64
+
+// IsYANGGoEnum ensures that NetworkDevice_Interface_Status implements the yang.GoEnum
+// interface. This ensures that NetworkDevice_Interface_Status can be identified as a
+// mapped type for a YANG enumeration.
+func (E_NetworkDevice_Interface_Status) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  NetworkDevice_Interface_Status.
+func (E_NetworkDevice_Interface_Status) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_NetworkDevice_Interface_Status.
+func (e E_NetworkDevice_Interface_Status) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_NetworkDevice_Interface_Status")
+}
+
+const (
+	// NetworkDevice_Interface_Status_UNSET corresponds to the value UNSET of NetworkDevice_Interface_Status
+	NetworkDevice_Interface_Status_UNSET E_NetworkDevice_Interface_Status = 0
+	// NetworkDevice_Interface_Status_up corresponds to the value up of NetworkDevice_Interface_Status
+	NetworkDevice_Interface_Status_up E_NetworkDevice_Interface_Status = 1
+	// NetworkDevice_Interface_Status_down corresponds to the value down of NetworkDevice_Interface_Status
+	NetworkDevice_Interface_Status_down E_NetworkDevice_Interface_Status = 2
+	// NetworkDevice_Interface_Status_testing corresponds to the value testing of NetworkDevice_Interface_Status
+	NetworkDevice_Interface_Status_testing E_NetworkDevice_Interface_Status = 3
+	// NetworkDevice_Interface_Status_dormant corresponds to the value dormant of NetworkDevice_Interface_Status
+	NetworkDevice_Interface_Status_dormant E_NetworkDevice_Interface_Status = 4
+)
+
+// ΛEnum is a map, keyed by the name of the type defined for each enum in the
+// generated Go code, which provides a mapping between the constant int64 value
+// of each value of the enumeration, and the string that is used to represent it
+// in the YANG schema. The map is named ΛEnum in order to avoid clash with any
+// valid YANG identifier.
+var ΛEnum = map[string]map[int64]ygot.EnumDefinition{
+	"E_NetworkDevice_InterfaceType": {
+		1: {Name: "ethernet", DefiningModule: "network-device"},
+		2: {Name: "wifi", DefiningModule: "network-device"},
+	},
+	"E_NetworkDevice_Interface_Duplex": {
+		1: {Name: "half"},
+		2: {Name: "full"},
+	},
+	"E_NetworkDevice_Interface_Speed": {
+		1: {Name: "10M"},
+		2: {Name: "100M"},
+		3: {Name: "1G"},
+		4: {Name: "10G"},
+	},
+	"E_NetworkDevice_Interface_Status": {
+		1: {Name: "up"},
+		2: {Name: "down"},
+		3: {Name: "testing"},
+		4: {Name: "dormant"},
+	},
+}
+
+var (
+	// ySchema is a byte slice contain a gzip compressed representation of the
+	// YANG schema from which the Go code was generated. When uncompressed the
+	// contents of the byte slice is a JSON document containing an object, keyed
+	// on the name of the generated struct, and containing the JSON marshalled
+	// contents of a goyang yang.Entry struct, which defines the schema for the
+	// fields within the struct.
+	ySchema = []byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5d, 0x5d, 0x73, 0xdb, 0xb6,
+		0xd2, 0xbe, 0xd7, 0xaf, 0xd8, 0xe1, 0x4d, 0x93, 0xf7, 0x15, 0x6d, 0xc9, 0xb1, 0x9d, 0xc4, 0x33,
+		0xe7, 0xc2, 0xad, 0x9b, 0x36, 0xd3, 0x3a, 0xcd, 0x34, 0x4e, 0xcf, 0x45, 0xea, 0xc9, 0xc0, 0xe4,
+		0x4a, 0xc2, 0x09, 0x05, 0xaa, 0x00, 0x68, 0x5b, 0x27, 0xf5, 0x7f, 0x3f, 0x03, 0x7e, 0xe8, 0x5b,
+		0xe4, 0x82, 0x94, 0x6c, 0xa9, 0x01, 0x2f, 0x5a, 0xc5, 0x5a, 0x50, 0xf8, 0x78, 0xb0, 0xbb, 0x58,
+		0x3c, 0x58, 0x7c, 0x6d, 0x01, 0x00, 0x78, 0xef, 0xd8, 0x10, 0xbd, 0x33, 0xf0, 0x42, 0xbc, 0xe5,
+		0x01, 0x7a, 0xed, 0xec, 0xaf, 0xbf, 0x70, 0x11, 0x7a, 0x67, 0xd0, 0xcd, 0xff, 0xf9, 0x43, 0x2c,
+		0x7a, 0xbc, 0xef, 0x9d, 0x41, 0x27, 0xff, 0xc3, 0x05, 0x97, 0xde, 0x19, 0x64, 0xaf, 0x00, 0x00,
+		0xf0, 0xb8, 0xd0, 0x28, 0x7b, 0x2c, 0xc0, 0xb9, 0x3f, 0xcf, 0xfd, 0xc2, 0x54, 0xa4, 0x3d, 0x2f,
+		0x70, 0x81, 0x2a, 0x90, 0x7c, 0xa4, 0x79, 0x2c, 0x8c, 0xdc, 0x3b, 0xd4, 0x77, 0xb1, 0xfc, 0x02,
+		0x13, 0x79, 0x08, 0xd2, 0x9f, 0x4f, 0x24, 0x4b, 0x45, 0x16, 0x4a, 0xcf, 0x57, 0x75, 0xf2, 0xe7,
+		0xc5, 0x2a, 0x4f, 0xbe, 0x78, 0x2f, 0xb1, 0xc7, 0xef, 0x97, 0xaa, 0x39, 0x57, 0x55, 0x81, 0xda,
+		0x6b, 0x2f, 0x7f, 0xfd, 0x21, 0x4e, 0xe4, 0x8a, 0x16, 0x4e, 0xab, 0x82, 0xe3, 0xbb, 0x58, 0x9a,
+		0xda, 0x78, 0xa3, 0xec, 0x57, 0xda, 0xab, 0x05, 0x7f, 0x66, 0xea, 0x5c, 0xf6, 0x93, 0x21, 0x0a,
+		0xed, 0x9d, 0x81, 0x96, 0x09, 0xae, 0x11, 0x9c, 0x91, 0x4a, 0x2b, 0xb5, 0x24, 0xf5, 0x30, 0xf7,
+		0x97, 0x87, 0xc5, 0x9e, 0x5d, 0x18, 0xa6, 0xc9, 0x17, 0x2c, 0x0c, 0x25, 0x2a, 0xe5, 0x0f, 0xe3,
+		0xb0, 0xa4, 0x3d, 0x45, 0x77, 0xcc, 0x49, 0xaf, 0xa9, 0xe9, 0xc2, 0x20, 0xfe, 0x1c, 0xdf, 0x81,
+		0x1e, 0xe0, 0xcc, 0x20, 0xf6, 0x51, 0x2b, 0xe0, 0x5a, 0xc1, 0xdb, 0xf7, 0xb7, 0xc7, 0x90, 0xbf,
+		0x12, 0xd5, 0xba, 0xf7, 0xe5, 0xc3, 0x7a, 0xb2, 0xe6, 0xeb, 0x75, 0xc3, 0x4b, 0x19, 0x66, 0xe2,
+		0x70, 0x53, 0x87, 0xdd, 0x7a, 0xf8, 0xad, 0x61, 0x40, 0x87, 0xc3, 0x6a, 0x58, 0xac, 0x81, 0x47,
+		0x25, 0x4c, 0x8a, 0xc7, 0x0b, 0xc7, 0x82, 0x0d, 0x79, 0x50, 0xdd, 0x05, 0x13, 0x6d, 0x92, 0x17,
+		0xa8, 0x68, 0x4f, 0x3e, 0xc8, 0xc7, 0x15, 0x62, 0x55, 0x83, 0x6d, 0x33, 0xe8, 0x96, 0x83, 0x6f,
+		0x0b, 0x82, 0xda, 0x60, 0xa8, 0x0d, 0x0a, 0x7b, 0x70, 0x94, 0x83, 0xa4, 0x02, 0x2c, 0x64, 0xd0,
+		0x4c, 0xc1, 0x33, 0x08, 0x46, 0xf4, 0x7e, 0x9b, 0x20, 0xc8, 0x94, 0x22, 0xb6, 0x7c, 0x41, 0xf7,
+		0xfc, 0x84, 0x1a, 0x98, 0x28, 0x54, 0x0c, 0xf4, 0x64, 0x3c, 0x04, 0x06, 0x17, 0x3f, 0xff, 0xf0,
+		0x1e, 0x14, 0xca, 0x5b, 0x94, 0xd4, 0xf7, 0xe6, 0xf0, 0xec, 0x10, 0xc5, 0xa9, 0x30, 0xad, 0x03,
+		0xd7, 0x9a, 0xb0, 0xad, 0x0b, 0xdf, 0xc6, 0x30, 0x6e, 0x0c, 0xe7, 0xfa, 0xb0, 0xa6, 0xc1, 0x9b,
+		0x08, 0xf3, 0xe2, 0xf1, 0xae, 0xc6, 0x23, 0xac, 0x37, 0x52, 0x37, 0x71, 0x1c, 0x21, 0x13, 0x36,
+		0xa3, 0x55, 0xf8, 0x34, 0xdd, 0xd6, 0x66, 0x1a, 0xda, 0x6c, 0xa6, 0x9f, 0x0b, 0x11, 0x6b, 0x96,
+		0xcf, 0x2e, 0xc2, 0x84, 0x57, 0xc1, 0x00, 0x87, 0x6c, 0xc4, 0xf4, 0xc0, 0x34, 0xff, 0x50, 0x64,
+		0xfe, 0x9c, 0x9f, 0x79, 0x98, 0x87, 0x13, 0x8f, 0xe0, 0x70, 0xd6, 0xad, 0x38, 0x2c, 0x2c, 0x46,
+		0xab, 0x5e, 0x3b, 0x4a, 0xda, 0xe0, 0x29, 0x53, 0x79, 0x0b, 0xe3, 0x95, 0xcb, 0x3b, 0xdb, 0xe5,
+		0x6c, 0x17, 0x1f, 0xdd, 0x1e, 0xfb, 0x39, 0x4e, 0xed, 0x6d, 0xd8, 0x5c, 0xe9, 0x7a, 0xb6, 0xec,
+		0x43, 0x8a, 0x45, 0x16, 0x45, 0x63, 0x60, 0x4a, 0xf1, 0xbe, 0xc0, 0x90, 0xe6, 0x40, 0xaf, 0xc3,
+		0xab, 0x33, 0x66, 0xce, 0x98, 0x35, 0x30, 0x66, 0x35, 0x20, 0x3d, 0x8b, 0xbe, 0xee, 0x2b, 0x8b,
+		0x32, 0xef, 0x99, 0xd6, 0x28, 0x85, 0x77, 0x06, 0x9f, 0xec, 0x7a, 0xf9, 0xd9, 0xb3, 0x4f, 0x1d,
+		0xff, 0xf5, 0xf5, 0xdf, 0x9f, 0xba, 0xfe, 0xeb, 0xeb, 0xec, 0x63, 0x37, 0xfd, 0x5f, 0xf6, 0xf9,
+		0xe8, 0x53, 0xc7, 0x3f, 0x2e, 0x3e, 0x9f, 0x7c, 0xea, 0xf8, 0x27, 0xd7, 0xcf, 0xff, 0xfc, 0xf3,
+		0xe0, 0xf9, 0xd7, 0x17, 0x0f, 0xf6, 0x05, 0xe9, 0x43, 0x78, 0xbd, 0xd1, 0x21, 0xfc, 0x95, 0x2b,
+		0x7d, 0xae, 0xb5, 0xb4, 0x1b, 0xc6, 0x4b, 0x2e, 0x7e, 0x8c, 0xd0, 0x20, 0x50, 0xd1, 0xa7, 0x76,
+		0x56, 0x92, 0xdd, 0xcf, 0x94, 0xec, 0xbe, 0x3a, 0x3e, 0x3e, 0x7d, 0x79, 0x7c, 0xdc, 0x79, 0xf9,
+		0xe2, 0x65, 0xe7, 0xf5, 0xc9, 0x49, 0xf7, 0xb4, 0x7b, 0x62, 0xf1, 0xb2, 0xdf, 0x64, 0x88, 0x12,
+		0xc3, 0xef, 0xc7, 0xde, 0x19, 0x88, 0x24, 0x8a, 0xea, 0x14, 0xfd, 0xa8, 0xd0, 0x34, 0xbe, 0xc7,
+		0x22, 0x85, 0xdf, 0x8e, 0x9b, 0x94, 0xfb, 0x26, 0x75, 0xbd, 0x24, 0xab, 0xb0, 0x00, 0xb1, 0x41,
+		0xb5, 0x1a, 0xe2, 0xb5, 0x68, 0xf5, 0x5b, 0x51, 0x37, 0x8f, 0x25, 0x3a, 0xf6, 0x05, 0xf6, 0x63,
+		0xcd, 0x99, 0xa6, 0x84, 0xaf, 0xe6, 0xe5, 0x69, 0x01, 0xac, 0x7f, 0x0f, 0x50, 0x0f, 0x50, 0xa6,
+		0x41, 0xac, 0x88, 0x8b, 0x2f, 0xa0, 0x46, 0x88, 0x21, 0x70, 0x05, 0x93, 0x37, 0x85, 0x70, 0xc7,
+		0xf5, 0x60, 0x2a, 0x31, 0xc2, 0xb5, 0x4b, 0xcb, 0x0a, 0xeb, 0xeb, 0xc2, 0x59, 0x9b, 0x0c, 0x67,
+		0x55, 0x5a, 0x37, 0x8b, 0xa5, 0x59, 0xc5, 0x52, 0x8c, 0x06, 0xd8, 0x1b, 0x26, 0xc2, 0x3b, 0x1e,
+		0xea, 0xc1, 0xda, 0x5a, 0x4d, 0x6b, 0x34, 0x11, 0xa5, 0xc1, 0xf4, 0x6d, 0x31, 0xbb, 0x60, 0x52,
+		0x12, 0xb8, 0x80, 0x4b, 0xec, 0xb3, 0x1b, 0xae, 0x15, 0x8c, 0x50, 0x82, 0xc2, 0x20, 0x16, 0xe1,
+		0x8e, 0x20, 0xd3, 0xc7, 0xfb, 0xfd, 0x44, 0x67, 0x5a, 0xf1, 0xc7, 0x47, 0x68, 0x31, 0xaa, 0xfe,
+		0xf0, 0x66, 0xa4, 0x08, 0x40, 0x7d, 0x59, 0x22, 0xf2, 0x51, 0xf0, 0xd4, 0x7a, 0x7b, 0x97, 0x15,
+		0xef, 0xfa, 0x9d, 0x89, 0x3e, 0x56, 0xfa, 0x5d, 0x04, 0x1b, 0x77, 0xc9, 0x05, 0x7d, 0xd9, 0xf4,
+		0x07, 0x8b, 0x12, 0x5c, 0xde, 0xca, 0x59, 0xf7, 0x78, 0x6f, 0x24, 0x0b, 0xcc, 0x3c, 0xb8, 0xe0,
+		0x7d, 0x6e, 0xe3, 0xcf, 0x78, 0xef, 0xb0, 0xcf, 0x34, 0xbf, 0x45, 0xb2, 0xfb, 0x40, 0x70, 0xca,
+		0x8c, 0x83, 0x54, 0xa3, 0xa9, 0x9d, 0x4e, 0xa7, 0xb3, 0x7b, 0xcd, 0xad, 0xe9, 0x5e, 0x5c, 0x37,
+		0xd0, 0x91, 0x41, 0x1c, 0x7f, 0xe1, 0x04, 0x63, 0x9e, 0xcb, 0xd1, 0xb4, 0xe3, 0x6f, 0x23, 0xf6,
+		0x57, 0x82, 0x70, 0x6b, 0x7a, 0x7b, 0xba, 0x7e, 0xd6, 0xf1, 0xc2, 0xd6, 0xd4, 0xcd, 0x18, 0x98,
+		0xd9, 0x64, 0xd4, 0x32, 0x8e, 0x22, 0x67, 0xc3, 0xf7, 0xcc, 0x86, 0x73, 0xc1, 0xe4, 0x98, 0xa0,
+		0x19, 0x5f, 0x37, 0x40, 0x67, 0x38, 0x87, 0xab, 0x0a, 0x88, 0xce, 0x0a, 0xd3, 0x70, 0xfa, 0x46,
+		0x22, 0xfa, 0xbd, 0x58, 0x0e, 0x61, 0xa6, 0x2c, 0xc4, 0xbd, 0x79, 0x9c, 0x3a, 0x5c, 0xee, 0x13,
+		0x2e, 0x95, 0x96, 0x5c, 0xf4, 0x29, 0xae, 0xe5, 0xab, 0x26, 0xc0, 0x4c, 0x46, 0x11, 0xde, 0x13,
+		0x30, 0x99, 0xc9, 0xd1, 0xe0, 0x78, 0x91, 0x0a, 0x83, 0x59, 0xa7, 0x1d, 0xc0, 0x05, 0x8e, 0x24,
+		0x06, 0x66, 0xb9, 0xd3, 0x06, 0xbc, 0x45, 0x39, 0x06, 0x95, 0x8c, 0x46, 0xb1, 0x34, 0xeb, 0x9f,
+		0x21, 0x86, 0x3c, 0x19, 0x82, 0x4c, 0x84, 0x82, 0x5e, 0x12, 0x45, 0x50, 0xfe, 0x33, 0x0e, 0xa8,
+		0xbb, 0x08, 0x54, 0x14, 0xc9, 0x10, 0x57, 0x72, 0x6b, 0x56, 0xa2, 0xb5, 0x64, 0xc3, 0xc3, 0xfb,
+		0x51, 0x24, 0xc3, 0xea, 0x3e, 0xbd, 0x8a, 0x3f, 0x64, 0x73, 0xe3, 0x8c, 0xe2, 0x42, 0x76, 0x4c,
+		0x1d, 0x07, 0x2c, 0xea, 0x51, 0x76, 0x3a, 0xba, 0x46, 0xd8, 0x20, 0xd1, 0x6b, 0x14, 0xca, 0xb9,
+		0x8a, 0xdf, 0x0a, 0x4d, 0xab, 0x5e, 0xfa, 0x63, 0x24, 0x9f, 0x35, 0x6b, 0xc4, 0x19, 0x74, 0x1e,
+		0x25, 0x78, 0x83, 0xf7, 0x5a, 0x32, 0x3f, 0x11, 0x4a, 0xb3, 0x9b, 0xa8, 0x02, 0x09, 0x26, 0xa8,
+		0x94, 0xa8, 0x4d, 0xf8, 0xfb, 0x53, 0x13, 0x58, 0x28, 0x8d, 0x2d, 0xef, 0x4f, 0xe5, 0x55, 0x7f,
+		0xcc, 0xfd, 0xa9, 0x99, 0xb6, 0xed, 0xa4, 0x27, 0x8d, 0xc2, 0x8c, 0x78, 0x58, 0x6d, 0x13, 0x0a,
+		0x41, 0xfb, 0x80, 0xd8, 0xd4, 0x75, 0xe6, 0x0a, 0x58, 0x38, 0xe4, 0x82, 0x2b, 0x2d, 0xd3, 0x45,
+		0x46, 0x34, 0x86, 0xca, 0xf7, 0xf6, 0x58, 0x12, 0xe9, 0x52, 0xb8, 0x79, 0x66, 0x6c, 0x56, 0x77,
+		0xef, 0xb5, 0x33, 0x2e, 0x2e, 0xc2, 0xb6, 0xf0, 0x4c, 0xb9, 0xa5, 0xbe, 0x2e, 0xab, 0xda, 0x32,
+		0x17, 0x35, 0x93, 0xa7, 0xcd, 0x80, 0x4b, 0x0c, 0x39, 0x03, 0x53, 0x60, 0xc9, 0x35, 0x6f, 0xc3,
+		0xdd, 0x80, 0x07, 0x03, 0xb8, 0x89, 0x13, 0x11, 0x66, 0x34, 0xc7, 0xcb, 0xab, 0x8f, 0xce, 0x0f,
+		0xda, 0x27, 0xa8, 0xf2, 0x10, 0x85, 0xe6, 0x7a, 0x2c, 0xb1, 0x47, 0x81, 0x6b, 0xc9, 0x7e, 0x97,
+		0xf7, 0x36, 0x7f, 0xd5, 0xf7, 0x4c, 0x21, 0x9d, 0x77, 0x42, 0x02, 0xe5, 0x7c, 0x08, 0x49, 0x91,
+		0x36, 0x47, 0x2d, 0x69, 0x0b, 0xa9, 0x9e, 0xa7, 0xb1, 0x30, 0xda, 0x9b, 0xfe, 0xed, 0x3b, 0xde,
+		0xe3, 0x4d, 0xcd, 0xea, 0xb5, 0x2d, 0x74, 0x68, 0x2a, 0x46, 0xf9, 0x43, 0x26, 0x58, 0x1f, 0x73,
+		0x90, 0x56, 0x69, 0x98, 0x39, 0x71, 0x9a, 0x82, 0x79, 0x2f, 0x51, 0xa1, 0xd0, 0x70, 0x37, 0x40,
+		0xb1, 0x6c, 0x67, 0xcd, 0x97, 0xf2, 0x16, 0x43, 0xe8, 0xc5, 0x12, 0xa6, 0xef, 0x06, 0x2d, 0x59,
+		0xaf, 0xb7, 0x96, 0xaf, 0xe4, 0x94, 0xcd, 0x4e, 0x2a, 0x1b, 0x1c, 0x8e, 0x34, 0x25, 0x68, 0xd5,
+		0x7d, 0xd1, 0x00, 0xb2, 0x51, 0xcc, 0x42, 0xbf, 0xc7, 0x02, 0x1d, 0xcb, 0x6a, 0xc0, 0xce, 0x0a,
+		0xd3, 0xe0, 0xfa, 0x61, 0xc0, 0xe4, 0xb2, 0x29, 0x9c, 0xdf, 0x88, 0x4a, 0x94, 0x0b, 0x5b, 0xed,
+		0x15, 0x30, 0x43, 0x0c, 0xf8, 0x90, 0x45, 0xa7, 0xc7, 0x14, 0x70, 0x1e, 0xb5, 0x5b, 0xf4, 0xed,
+		0x8a, 0xa3, 0x9d, 0xdd, 0x70, 0xaa, 0xbd, 0x03, 0x73, 0xb4, 0x8f, 0x1b, 0x4e, 0xbb, 0xd7, 0xd8,
+		0x27, 0x58, 0x24, 0x0f, 0x59, 0x50, 0xc9, 0xeb, 0x9c, 0xcc, 0x89, 0x59, 0x61, 0xe2, 0xf1, 0x27,
+		0x26, 0xc3, 0x3b, 0xa3, 0x1d, 0xf3, 0x62, 0x2e, 0x96, 0xbf, 0xd7, 0x4a, 0xb1, 0x1a, 0x00, 0x40,
+		0x24, 0x39, 0x92, 0x49, 0x8d, 0x9e, 0xa1, 0x19, 0x32, 0xbf, 0x77, 0xee, 0xbf, 0xb9, 0xfe, 0x7a,
+		0xf4, 0xf0, 0xec, 0x6c, 0xfe, 0xdf, 0xcf, 0xbf, 0x9e, 0x3c, 0x78, 0xdb, 0x99, 0x19, 0x3a, 0x21,
+		0xcc, 0x08, 0x9d, 0x50, 0x17, 0xcd, 0xec, 0x9e, 0x0f, 0x93, 0x21, 0x5c, 0x49, 0x26, 0xd4, 0x90,
+		0x2b, 0xc5, 0x63, 0x01, 0x86, 0x88, 0x00, 0x5c, 0xc0, 0xcd, 0x58, 0x57, 0x9e, 0x00, 0x74, 0x53,
+		0x61, 0xb7, 0xa6, 0x82, 0x4e, 0x7c, 0xc5, 0xff, 0x8b, 0x84, 0x79, 0x70, 0x4a, 0xa1, 0xa2, 0x94,
+		0x41, 0x00, 0x9e, 0xde, 0x35, 0x38, 0x7d, 0xf5, 0xed, 0x90, 0x51, 0x4e, 0x4f, 0x4e, 0x5e, 0x9c,
+		0x38, 0x32, 0x0a, 0x80, 0x27, 0x32, 0xb0, 0x57, 0x28, 0xc1, 0x54, 0xca, 0x96, 0xa6, 0x67, 0x0a,
+		0xc1, 0x33, 0x3c, 0xe8, 0x1f, 0xb4, 0x01, 0xf5, 0xa0, 0xd3, 0x86, 0xbb, 0x88, 0x89, 0xce, 0x73,
+		0xa7, 0x05, 0x9f, 0x5e, 0x0b, 0x5e, 0x32, 0x11, 0x32, 0x1d, 0xcb, 0xf1, 0xfa, 0x0d, 0xbd, 0xed,
+		0x10, 0x00, 0x36, 0xe1, 0x2f, 0xa0, 0x1e, 0xa4, 0x27, 0x13, 0xfe, 0xff, 0x6f, 0x03, 0xa8, 0xfc,
+		0x63, 0x14, 0x67, 0x1f, 0xb6, 0xe3, 0x2a, 0x8c, 0x98, 0x52, 0xf9, 0x48, 0x57, 0x4c, 0x95, 0x89,
+		0x24, 0x31, 0xb2, 0x80, 0x81, 0x44, 0x0d, 0x89, 0xca, 0xd8, 0x5a, 0x2c, 0xd1, 0x03, 0x13, 0x50,
+		0x35, 0x9b, 0x6e, 0x8e, 0x6e, 0xbd, 0x6b, 0xb3, 0x66, 0xd7, 0x28, 0x31, 0x23, 0xc9, 0x63, 0xc9,
+		0xf5, 0x98, 0x80, 0xca, 0x42, 0xd2, 0x56, 0x89, 0x17, 0x05, 0x21, 0xc2, 0x5b, 0x8c, 0x1c, 0x08,
+		0xf7, 0x09, 0x84, 0xc5, 0xd8, 0xf9, 0x65, 0x63, 0x07, 0xd5, 0x29, 0x48, 0xc0, 0x11, 0xa5, 0xc9,
+		0xc3, 0xd3, 0xdc, 0x37, 0xdd, 0x3b, 0xbf, 0xb4, 0xfd, 0x34, 0x88, 0xe8, 0x7c, 0x43, 0xdc, 0x79,
+		0xb7, 0x56, 0x01, 0x00, 0x2f, 0x3d, 0x97, 0x56, 0x6d, 0xeb, 0x32, 0x31, 0x22, 0x1d, 0x99, 0xdf,
+		0x63, 0x38, 0x73, 0xea, 0xad, 0x0d, 0xb1, 0x88, 0xc6, 0x86, 0x46, 0xcf, 0x43, 0x43, 0x74, 0x88,
+		0x10, 0xe6, 0xcf, 0xd4, 0x01, 0x57, 0x20, 0x62, 0x9d, 0xea, 0x7f, 0x67, 0x0d, 0x1d, 0xf9, 0x73,
+		0xb3, 0xe4, 0xcf, 0x6e, 0xe7, 0x92, 0xcc, 0xfd, 0xec, 0x76, 0x68, 0xc2, 0x47, 0xa9, 0xf0, 0x4f,
+		0x14, 0xd1, 0x17, 0xd9, 0x7b, 0x7f, 0x7a, 0x34, 0x4a, 0x69, 0xda, 0x06, 0x1a, 0xa5, 0xd4, 0x54,
+		0xeb, 0x0c, 0x5e, 0x90, 0x24, 0x2f, 0x69, 0x9a, 0xcf, 0xf4, 0xca, 0x19, 0x1c, 0x6d, 0x96, 0xa4,
+		0x4a, 0xd3, 0x64, 0x9a, 0x74, 0x9c, 0x37, 0x13, 0xa3, 0x1e, 0x00, 0xca, 0xc1, 0xcd, 0x22, 0x48,
+		0xcb, 0xd9, 0x6e, 0xc4, 0x74, 0xab, 0xd4, 0xd5, 0x91, 0x53, 0x57, 0x8d, 0xd5, 0x55, 0x65, 0xfe,
+		0xb9, 0x20, 0x4e, 0xcc, 0x78, 0x29, 0x3a, 0x97, 0x6a, 0x52, 0xa2, 0x2a, 0x13, 0xcc, 0xba, 0x25,
+		0x5e, 0xce, 0xaa, 0x01, 0xea, 0x8b, 0xca, 0xf1, 0xb2, 0x8c, 0x1b, 0x97, 0x0e, 0x68, 0x13, 0x38,
+		0xab, 0x56, 0x48, 0xb0, 0xd1, 0x74, 0x40, 0xc2, 0x8f, 0x03, 0x8d, 0xba, 0x4e, 0x2e, 0xa0, 0x49,
+		0xd1, 0x7a, 0x89, 0x80, 0x7e, 0x4b, 0x0b, 0x83, 0xc4, 0x00, 0xb9, 0x21, 0x81, 0xc5, 0x82, 0xa4,
+		0xc7, 0xd6, 0xe1, 0xd4, 0xa5, 0x01, 0x72, 0x69, 0x80, 0x1a, 0xa4, 0x01, 0x4a, 0xb8, 0xd0, 0xa5,
+		0x14, 0xa1, 0x75, 0xb8, 0xb3, 0xc9, 0xff, 0x43, 0x0b, 0xae, 0x2c, 0x3e, 0x76, 0x60, 0x00, 0xdb,
+		0xa5, 0xf6, 0xda, 0xe5, 0x68, 0xa7, 0x5d, 0xaf, 0x7c, 0xdd, 0xd5, 0x69, 0xf3, 0xd5, 0x6a, 0x4d,
+		0xd8, 0xd4, 0x5e, 0xb9, 0xaf, 0xed, 0xba, 0x66, 0xd9, 0x84, 0x76, 0xb5, 0x37, 0x5b, 0xdb, 0x91,
+		0xbe, 0xde, 0x54, 0xb2, 0xa3, 0xea, 0x8e, 0xf1, 0xe2, 0x44, 0xd7, 0x36, 0x78, 0x33, 0x65, 0x1b,
+		0x59, 0xbc, 0x94, 0x11, 0xed, 0xac, 0x9d, 0xb3, 0x76, 0x00, 0xce, 0xda, 0x2d, 0x3d, 0xce, 0xda,
+		0x39, 0x6b, 0xe7, 0xac, 0xdd, 0xbe, 0xa6, 0xf6, 0x4b, 0x43, 0x52, 0x87, 0xc4, 0x10, 0x43, 0xf6,
+		0x66, 0x2d, 0x93, 0x40, 0xe7, 0xfc, 0xa4, 0xe2, 0xaa, 0x8c, 0x8b, 0xf4, 0xbd, 0x9f, 0x27, 0xc1,
+		0x8b, 0xcf, 0x26, 0x6b, 0x2c, 0x7e, 0xfe, 0xa1, 0x78, 0xef, 0x16, 0x72, 0x2a, 0xff, 0x27, 0x19,
+		0xde, 0xc4, 0x7e, 0x19, 0x57, 0x74, 0x49, 0x8b, 0x4e, 0x8b, 0xd8, 0xc5, 0x64, 0x7e, 0x65, 0xb2,
+		0x8f, 0x4a, 0x9b, 0x03, 0x95, 0x4b, 0xd1, 0xbb, 0x2c, 0x03, 0x5f, 0xfa, 0x66, 0xe8, 0x49, 0x36,
+		0x44, 0x65, 0x4e, 0x62, 0xc6, 0x0a, 0x41, 0x1a, 0x75, 0x0a, 0x5c, 0xa5, 0xf2, 0x97, 0x57, 0x1f,
+		0xcd, 0x07, 0xa6, 0x41, 0x20, 0x86, 0xe9, 0x9f, 0x7a, 0xc8, 0x74, 0x22, 0x91, 0x18, 0xd6, 0xe9,
+		0xb8, 0xb0, 0x4e, 0x73, 0x87, 0xe0, 0xb1, 0xc3, 0x3a, 0x64, 0x83, 0x6f, 0x43, 0x6e, 0xb5, 0x20,
+		0xb9, 0xda, 0x93, 0x5d, 0x6b, 0x3a, 0x03, 0x76, 0x09, 0x60, 0xed, 0x5d, 0xc8, 0xe9, 0x8e, 0x6b,
+		0xa7, 0xdb, 0x6e, 0x3d, 0xaa, 0x85, 0xaa, 0x6f, 0x99, 0x1e, 0xec, 0x92, 0xdb, 0xd6, 0xef, 0x15,
+		0x0b, 0xda, 0xec, 0x2e, 0x74, 0xcb, 0x86, 0x0c, 0xea, 0x75, 0xa3, 0xa9, 0x69, 0x91, 0x9d, 0xa4,
+		0x78, 0x3c, 0xde, 0xf3, 0x0b, 0xa5, 0xbd, 0x85, 0x89, 0x31, 0x6f, 0xa5, 0x32, 0x5b, 0xf2, 0xc8,
+		0xcb, 0xb3, 0x99, 0x06, 0x3e, 0xe5, 0x12, 0x6d, 0xae, 0x07, 0x76, 0x0d, 0x57, 0x3b, 0x9a, 0xda,
+		0xb8, 0x6c, 0x4f, 0x12, 0x2c, 0xfd, 0x36, 0xaf, 0xe1, 0x16, 0x6a, 0xa2, 0x68, 0x7b, 0xa8, 0x89,
+		0xb2, 0xe6, 0x3d, 0xc6, 0x0b, 0xdb, 0xa9, 0x89, 0x72, 0x59, 0x65, 0xf7, 0x31, 0xab, 0x6c, 0x22,
+		0x88, 0x7c, 0x8f, 0xd7, 0x25, 0x32, 0xf9, 0xcf, 0x6d, 0x2c, 0x67, 0x14, 0x8d, 0x8a, 0x62, 0x43,
+		0x49, 0xb1, 0xa3, 0xa6, 0xd4, 0xa3, 0xa8, 0xcc, 0x53, 0x55, 0x92, 0x91, 0x8d, 0xcd, 0x48, 0x19,
+		0x2b, 0x61, 0x7c, 0x67, 0x75, 0x37, 0x50, 0xca, 0x5c, 0xd1, 0xa8, 0x74, 0x39, 0x95, 0x7a, 0x35,
+		0x8d, 0x25, 0x8c, 0xe5, 0x90, 0x09, 0x62, 0x08, 0x8e, 0x1c, 0x58, 0x23, 0x53, 0x5b, 0x8a, 0x67,
+		0x52, 0x0f, 0x12, 0x77, 0x65, 0xa6, 0xd4, 0x9d, 0xa0, 0xd3, 0x5e, 0x01, 0x60, 0xda, 0x51, 0xe4,
+		0xe3, 0xcb, 0x00, 0x90, 0x0e, 0x63, 0x55, 0x9e, 0xb6, 0x6a, 0x8b, 0x44, 0x93, 0x78, 0x68, 0x6f,
+		0x6a, 0xfe, 0x54, 0xb2, 0xeb, 0x97, 0xa6, 0x0e, 0x21, 0x3c, 0x69, 0x7d, 0x09, 0x87, 0x37, 0x64,
+		0xc6, 0x2c, 0x0a, 0x26, 0x02, 0xf4, 0x0f, 0xfe, 0xcf, 0xdb, 0x9a, 0xc1, 0x6f, 0xc2, 0x99, 0xd4,
+		0xac, 0x4f, 0x30, 0x92, 0xa9, 0x94, 0x6d, 0x02, 0xd7, 0x88, 0xdd, 0x60, 0xa4, 0x80, 0x69, 0xcd,
+		0x82, 0xc1, 0x8a, 0x64, 0xc3, 0x4d, 0xf9, 0x46, 0x8e, 0x1e, 0x49, 0x87, 0x49, 0x6d, 0xbe, 0x91,
+		0x66, 0x7d, 0x7a, 0x5c, 0xcb, 0x08, 0xdb, 0x45, 0xb4, 0xce, 0x33, 0x94, 0xb4, 0x81, 0x29, 0x60,
+		0xf0, 0x05, 0xc7, 0xc0, 0x44, 0x98, 0x67, 0xa9, 0x1e, 0x31, 0x2e, 0x1d, 0xd7, 0xc8, 0x71, 0x8d,
+		0xbc, 0x2f, 0x38, 0xb6, 0xdf, 0x74, 0x35, 0x85, 0xea, 0xed, 0xb6, 0x9a, 0x37, 0x14, 0xe1, 0xd5,
+		0x14, 0x9d, 0x6e, 0x8b, 0x95, 0xfa, 0xb8, 0x2d, 0x56, 0x00, 0x80, 0x66, 0x5b, 0xac, 0x64, 0xe7,
+		0x85, 0x78, 0x54, 0xd0, 0xbe, 0x9d, 0x84, 0x36, 0x7a, 0xb7, 0x79, 0xc8, 0xcf, 0x72, 0x56, 0x66,
+		0xc5, 0xea, 0xcd, 0xcb, 0x34, 0xca, 0xe8, 0x26, 0xa6, 0x9b, 0x98, 0xdf, 0xee, 0xc4, 0x6c, 0x64,
+		0x6f, 0x7f, 0xc1, 0x31, 0xcd, 0x30, 0xda, 0xdd, 0xa8, 0x67, 0x7f, 0x93, 0xde, 0x46, 0x6e, 0xd0,
+		0xab, 0x71, 0x73, 0x5e, 0x8d, 0x1b, 0xf3, 0x9e, 0x6a, 0x3b, 0xdc, 0x2c, 0xb8, 0x0e, 0xab, 0xdd,
+		0x69, 0xa0, 0x07, 0x54, 0xaf, 0x58, 0x5f, 0x99, 0xff, 0xec, 0xe7, 0x85, 0x79, 0x25, 0x0b, 0x50,
+		0xcb, 0x3e, 0x68, 0x12, 0x53, 0xd6, 0x89, 0x10, 0x18, 0x11, 0x96, 0xcb, 0x99, 0x1c, 0x6d, 0xc1,
+		0x7c, 0x95, 0x0a, 0x83, 0x42, 0x6d, 0xc2, 0x33, 0xaa, 0xc8, 0x9f, 0x3c, 0x64, 0x63, 0xb8, 0x41,
+		0x88, 0xb0, 0xa7, 0x21, 0x4d, 0x91, 0x09, 0x3a, 0x86, 0x44, 0x65, 0x59, 0x1f, 0xc2, 0x2c, 0x71,
+		0xb8, 0x72, 0x4b, 0xe8, 0x3d, 0x58, 0x42, 0x4b, 0x1c, 0xc6, 0x1a, 0xc9, 0x77, 0x27, 0x4f, 0x3a,
+		0x76, 0xa1, 0x9c, 0xe5, 0xc2, 0x7a, 0x3e, 0xdb, 0x5e, 0x8f, 0x49, 0x40, 0x11, 0x16, 0xff, 0x2c,
+		0x05, 0xe8, 0x22, 0x8a, 0x1c, 0xe3, 0xa3, 0x01, 0xea, 0xec, 0xd1, 0x57, 0xad, 0x8a, 0x61, 0x2b,
+		0x8c, 0x0f, 0xcb, 0xfb, 0x8c, 0xb7, 0x1b, 0x3a, 0xdd, 0xad, 0x7b, 0x8b, 0xaf, 0x77, 0xee, 0x9e,
+		0x92, 0x51, 0x9a, 0x1f, 0x3b, 0xd8, 0xe8, 0xae, 0xd3, 0xd5, 0x6c, 0x70, 0x16, 0x50, 0x04, 0x6c,
+		0xa4, 0x92, 0x88, 0x69, 0xcc, 0xb2, 0xf8, 0x17, 0xc7, 0x00, 0xb9, 0x00, 0x46, 0x53, 0x20, 0x1b,
+		0x98, 0x99, 0x59, 0x23, 0x1f, 0x73, 0x6e, 0xd6, 0xe8, 0x85, 0xc7, 0x4e, 0x86, 0xf0, 0x58, 0x3e,
+		0x57, 0xd5, 0x18, 0x53, 0xbd, 0xae, 0x92, 0x5e, 0xa2, 0xf9, 0x5d, 0xb7, 0x11, 0x13, 0x3e, 0x27,
+		0xa4, 0x76, 0x28, 0x04, 0x69, 0x9e, 0xd7, 0x79, 0x10, 0x18, 0x13, 0xf9, 0xc7, 0xaf, 0xe7, 0xef,
+		0x56, 0x5c, 0x63, 0xb1, 0x94, 0xe8, 0x81, 0x6b, 0xe0, 0xaa, 0xea, 0x4a, 0x17, 0x97, 0xdb, 0xa1,
+		0x89, 0xed, 0xdb, 0xda, 0x2e, 0x3f, 0x17, 0xba, 0x7b, 0xda, 0x30, 0x51, 0xa7, 0xcb, 0x70, 0x44,
+		0x1a, 0x96, 0xda, 0x74, 0xc2, 0x49, 0x53, 0x8f, 0x3b, 0xaf, 0x8f, 0xff, 0xe9, 0x09, 0x6d, 0x36,
+		0xe0, 0x03, 0x98, 0xbb, 0x31, 0x36, 0x69, 0xff, 0x0f, 0x0e, 0x0e, 0x73, 0xe5, 0x06, 0xff, 0x82,
+		0xef, 0xcc, 0xb4, 0xfe, 0x6e, 0xcb, 0x06, 0x3e, 0x6d, 0xc1, 0x63, 0x1a, 0xf7, 0x55, 0x4d, 0x7c,
+		0xfa, 0x54, 0x46, 0xad, 0x12, 0x64, 0x78, 0xe7, 0x49, 0xdf, 0xd4, 0x1e, 0xc3, 0x95, 0x43, 0x5d,
+		0x61, 0x0f, 0x8d, 0x71, 0x3f, 0xdb, 0xb5, 0x0d, 0x77, 0xc7, 0x50, 0xa3, 0x44, 0x0d, 0xaa, 0x6f,
+		0xca, 0x5f, 0xea, 0xdb, 0xaa, 0x1b, 0xf3, 0xd7, 0xf9, 0x41, 0x8d, 0x6f, 0xce, 0x5f, 0x44, 0xd2,
+		0x53, 0x47, 0x0c, 0x2a, 0x10, 0xb6, 0x09, 0xd5, 0xf5, 0x34, 0x51, 0x83, 0x72, 0x04, 0x12, 0x15,
+		0xd6, 0xc6, 0x23, 0x07, 0xe4, 0x9b, 0xf9, 0x17, 0x71, 0xf2, 0xd2, 0xe6, 0xc4, 0xc8, 0x25, 0xf1,
+		0xdd, 0x3b, 0x7a, 0x60, 0xc4, 0x9d, 0x16, 0x69, 0x70, 0xe3, 0xff, 0x2e, 0x74, 0xcb, 0x53, 0xb3,
+		0xfa, 0xdb, 0x84, 0x5b, 0x6b, 0x89, 0x86, 0x82, 0x74, 0x55, 0xec, 0x06, 0xb8, 0xef, 0x8b, 0xf3,
+		0xdd, 0xd9, 0x85, 0x6f, 0xca, 0x2e, 0x54, 0x71, 0xeb, 0x6d, 0x38, 0xf6, 0x8b, 0xd5, 0xd8, 0xda,
+		0xa9, 0x27, 0x3b, 0xee, 0xfd, 0x52, 0x13, 0x8e, 0x2d, 0xca, 0x58, 0x71, 0xf1, 0x9b, 0x71, 0xf2,
+		0x1b, 0x70, 0xf3, 0x1b, 0x71, 0xf4, 0x9b, 0x72, 0xf5, 0x1b, 0x70, 0xf6, 0x89, 0xb8, 0xde, 0x00,
+		0x87, 0xbf, 0x78, 0xea, 0x71, 0xf9, 0x1b, 0x70, 0xfa, 0x8b, 0xa7, 0x1e, 0xb7, 0xbf, 0x78, 0x6c,
+		0x38, 0xfe, 0x34, 0x55, 0x62, 0x2f, 0x49, 0x1c, 0xa4, 0xc7, 0x65, 0xed, 0x58, 0x94, 0xb1, 0xdd,
+		0xe0, 0xaa, 0x7d, 0x46, 0x80, 0xe6, 0x46, 0xd0, 0x3b, 0xff, 0x7a, 0xdb, 0xd9, 0x4d, 0x5b, 0x25,
+		0x37, 0x96, 0x57, 0x6d, 0x59, 0x50, 0xb7, 0x2a, 0xbc, 0x76, 0xab, 0xde, 0xde, 0x84, 0xd7, 0x5a,
+		0x5d, 0xd7, 0x19, 0x38, 0x7a, 0x6a, 0xac, 0x34, 0x2e, 0xeb, 0xe9, 0x29, 0xc0, 0xb2, 0xef, 0xdb,
+		0xad, 0x32, 0xe7, 0x29, 0xfb, 0x61, 0xff, 0x8e, 0x87, 0x08, 0x41, 0xea, 0xe5, 0x24, 0x2b, 0x6d,
+		0xcc, 0x9a, 0x58, 0xcc, 0x5a, 0xcf, 0xa8, 0xcc, 0x13, 0xaa, 0xd8, 0x27, 0xa8, 0xf2, 0x72, 0xc8,
+		0x5e, 0x0d, 0xd9, 0x8b, 0xa9, 0xde, 0x07, 0x28, 0x0f, 0x85, 0xad, 0x8b, 0x99, 0x78, 0x39, 0x1d,
+		0xc6, 0x27, 0x5d, 0xd8, 0x36, 0x2b, 0x4c, 0xbc, 0xb8, 0x6d, 0x45, 0xba, 0x8d, 0x3c, 0x89, 0x46,
+		0x18, 0x83, 0x88, 0x35, 0x28, 0xd4, 0xe6, 0x6b, 0x2e, 0x61, 0xbd, 0x79, 0x74, 0x5b, 0x43, 0xb5,
+		0x20, 0x51, 0xa1, 0x70, 0xdc, 0x2d, 0x6e, 0xee, 0x16, 0xb7, 0x0d, 0xa4, 0xa3, 0xf8, 0x87, 0xdf,
+		0x8c, 0x10, 0x0a, 0xe5, 0xa7, 0x57, 0xa6, 0x13, 0x6e, 0xbf, 0x9e, 0x91, 0xa5, 0xa9, 0xc7, 0x8b,
+		0x77, 0x1f, 0x20, 0x2b, 0xa0, 0xda, 0xf0, 0x57, 0x82, 0x92, 0x63, 0x08, 0x3c, 0x4b, 0x53, 0x18,
+		0x1b, 0xc6, 0xad, 0xf9, 0x34, 0x06, 0x26, 0x11, 0x22, 0xae, 0xb4, 0xdb, 0x3b, 0xff, 0xc6, 0xae,
+		0xaa, 0x6a, 0xb7, 0xea, 0x93, 0xcc, 0xe9, 0xe4, 0xf2, 0x46, 0xa4, 0xf2, 0x39, 0x32, 0x39, 0x31,
+		0x88, 0x96, 0x28, 0xac, 0x3c, 0x9e, 0x68, 0x11, 0x48, 0x9a, 0x85, 0x52, 0x9c, 0xd5, 0xc6, 0xbf,
+		0xa1, 0x1c, 0x61, 0xab, 0x15, 0x44, 0x9a, 0x83, 0x55, 0xda, 0x92, 0x2d, 0x84, 0x25, 0x17, 0xe9,
+		0xf6, 0xa6, 0x6a, 0x4d, 0xae, 0xa2, 0xd4, 0x23, 0xb2, 0x12, 0x9b, 0x91, 0xa5, 0x29, 0xb1, 0x77,
+		0x57, 0xef, 0xa7, 0x4a, 0x8c, 0x0b, 0x10, 0x31, 0x8c, 0x98, 0xd4, 0x3c, 0x48, 0x22, 0x26, 0x33,
+		0x35, 0xe6, 0xd4, 0x96, 0x53, 0x5b, 0x3b, 0xac, 0xb6, 0xca, 0xcf, 0xc0, 0x58, 0x9c, 0x7d, 0xb1,
+		0x66, 0x28, 0x34, 0x5a, 0xc4, 0xaf, 0x5c, 0x3e, 0x43, 0xd5, 0x0a, 0xfe, 0x43, 0x56, 0x6a, 0xdd,
+		0xf2, 0xbd, 0x35, 0x53, 0xcf, 0x75, 0xf5, 0xf3, 0xb8, 0x7a, 0xc3, 0xbe, 0xe0, 0xef, 0x71, 0xbc,
+		0x0c, 0xe9, 0xc5, 0x3a, 0x7b, 0xed, 0xd6, 0x9a, 0x6a, 0x65, 0xf5, 0xf1, 0xb2, 0x1f, 0x6c, 0x3d,
+		0xfc, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0xd0, 0x2e, 0xf6, 0xde, 0x4b, 0xb6, 0x00, 0x00,
+	}
+)
+
+// ΛEnumTypes is a map, keyed by a YANG schema path, of the enumerated types that
+// correspond with the leaf. The type is represented as a reflect.Type. The naming
+// of the map ensures that there are no clashes with valid YANG identifiers.
+func initΛEnumTypes() {
+	ΛEnumTypes = map[string][]reflect.Type{
+		"/interface/duplex": []reflect.Type{
+			reflect.TypeOf((E_NetworkDevice_Interface_Duplex)(0)),
+		},
+		"/interface/interface-type": []reflect.Type{
+			reflect.TypeOf((E_NetworkDevice_InterfaceType)(0)),
+		},
+		"/interface/speed": []reflect.Type{
+			reflect.TypeOf((E_NetworkDevice_Interface_Speed)(0)),
+		},
+		"/interface/status": []reflect.Type{
+			reflect.TypeOf((E_NetworkDevice_Interface_Status)(0)),
+		},
+	}
+}
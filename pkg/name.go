@@ -0,0 +1,41 @@
+package network
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// NameAliases maps the interface types that devices use in their names, such
+// as GigabitEthernet in GigabitEthernet0/0, to the prefix of the names that
+// the model accepts. Add entries to it to normalize the names of other
+// devices.
+var NameAliases = map[string]string{
+	"Ethernet":           "eth",
+	"GigabitEthernet":    "eth",
+	"TenGigabitEthernet": "eth",
+	"Wlan":               "wlan",
+}
+
+// longName splits the long form of an interface name into its type and its
+// slot and port numbers, e.g. GigabitEthernet and 0/1.
+var longName = regexp.MustCompile(`^([A-Za-z-]+)([0-9]+(?:/[0-9]+)*)$`)
+
+// NormalizeName returns the short form of the interface name, looking up its
+// type in NameAliases and keeping its last number, the port. For instance,
+// GigabitEthernet0/1 becomes eth1. Names already in their short form are
+// returned unchanged. It returns an error when the result is not a valid
+// interface name.
+func NormalizeName(name string) (string, error) {
+	short := name
+	if m := longName.FindStringSubmatch(name); m != nil {
+		if prefix, ok := NameAliases[m[1]]; ok {
+			short = prefix + m[2][strings.LastIndex(m[2], "/")+1:]
+		}
+	}
+	e := childEntry(SchemaTree["NetworkDevice_Interface"], "name")
+	if err := validateJSONLeaf(e.Type, short); err != nil {
+		return "", fmt.Errorf("cannot normalize interface name %q: %w", name, err)
+	}
+	return short, nil
+}
@@ -0,0 +1,64 @@
+package network
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "GigabitEthernet0/0", want: "eth0"},
+		{in: "TenGigabitEthernet1/0/3", want: "eth3"},
+		{in: "Ethernet2", want: "eth2"},
+		{in: "eth0", want: "eth0"},
+		{in: "wlan1", want: "wlan1"},
+		{in: "Serial0/0", wantErr: true},
+		{in: "GigabitEthernet", wantErr: true},
+		{in: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := NormalizeName(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeName(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeName(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeNameAliases(t *testing.T) {
+	NameAliases["FastEthernet"] = "eth"
+	defer delete(NameAliases, "FastEthernet")
+
+	if got, err := NormalizeName("FastEthernet0/2"); err != nil || got != "eth2" {
+		t.Errorf("NormalizeName(FastEthernet0/2) = %q, %v, want eth2", got, err)
+	}
+}
+
+func TestParseDeviceNormalizeNames(t *testing.T) {
+	in := []byte(`{"interface": {"name": "GigabitEthernet0/0"}}`)
+
+	if err := ParseDevice(in, &Device{}); !errors.Is(err, ErrValidation) {
+		t.Errorf("ParseDevice(GigabitEthernet0/0) error = %v, want ErrValidation", err)
+	}
+
+	var d Device
+	if err := ParseDevice(in, &d, NormalizeNames()); err != nil {
+		t.Fatalf("ParseDevice(GigabitEthernet0/0, NormalizeNames()) error = %v", err)
+	}
+	if got := d.GetInterface().GetName(); got != "eth0" {
+		t.Errorf("ParseDevice(GigabitEthernet0/0, NormalizeNames()) name = %q, want eth0", got)
+	}
+
+	err := ParseDevice([]byte(`{"interface": {"name": "Serial0/0"}}`), &Device{}, NormalizeNames())
+	if !errors.Is(err, ErrValidation) {
+		t.Errorf("ParseDevice(Serial0/0, NormalizeNames()) error = %v, want ErrValidation", err)
+	}
+}
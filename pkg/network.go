@@ -135,6 +135,7 @@ func Unmarshal(data []byte, destStruct ygot.GoStruct, opts ...ytypes.UnmarshalOp
 // Device represents the /device YANG schema element.
 type Device struct {
 	Interface *NetworkDevice_Interface `path:"interface" module:"network-device"`
+	System    *NetworkDevice_System    `path:"system" module:"network-device"`
 }
 
 // IsYANGGoStruct ensures that Device implements the yang.GoStruct
@@ -152,6 +153,16 @@ func (t *Device) GetOrCreateInterface() *NetworkDevice_Interface {
 	return t.Interface
 }
 
+// GetOrCreateSystem retrieves the value of the System field
+// or returns the existing field if it already exists.
+func (t *Device) GetOrCreateSystem() *NetworkDevice_System {
+	if t.System != nil {
+		return t.System
+	}
+	t.System = &NetworkDevice_System{}
+	return t.System
+}
+
 // GetInterface returns the value of the Interface struct pointer
 // from Device. If the receiver or the field Interface is nil, nil
 // is returned such that the Get* methods can be safely chained.
@@ -162,6 +173,28 @@ func (t *Device) GetInterface() *NetworkDevice_Interface {
 	return nil
 }
 
+// GetSystem returns the value of the System struct pointer
+// from Device. If the receiver or the field System is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Device) GetSystem() *NetworkDevice_System {
+	if t != nil && t.System != nil {
+		return t.System
+	}
+	return nil
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Device
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Device) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	t.Interface.PopulateDefaults()
+	t.System.PopulateDefaults()
+}
+
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Device) ΛValidate(opts ...ygot.ValidationOption) error {
 	if err := ytypes.Validate(SchemaTree["Device"], t, opts...); err != nil {
@@ -187,11 +220,28 @@ func (*Device) ΛBelongingModule() string {
 
 // NetworkDevice_Interface represents the /network-device/interface YANG schema element.
 type NetworkDevice_Interface struct {
-	Bandwidth *uint32                              `path:"bandwidth" module:"network-device-extensions"`
-	Mtu       *uint16                              `path:"mtu" module:"network-device"`
-	Name      *string                              `path:"name" module:"network-device"`
-	Priority  *uint8                               `path:"priority" module:"network-device"`
-	Status    NetworkDevice_Interface_Status_Union `path:"status" module:"network-device-extensions"`
+	AutoNegotiate *bool                                `path:"auto-negotiate" module:"network-device"`
+	Bandwidth     *uint32                              `path:"bandwidth" module:"network-device-extensions"`
+	Cookie        Binary                               `path:"cookie" module:"network-device"`
+	Description   *string                              `path:"description" module:"network-device"`
+	Dhcp          *bool                                `path:"dhcp" module:"network-device"`
+	Duplex        E_NetworkDevice_Interface_Duplex     `path:"duplex" module:"network-device"`
+	Enabled       *bool                                `path:"enabled" module:"network-device"`
+	InterfaceType E_NetworkDevice_InterfaceType        `path:"interface-type" module:"network-device"`
+	Ipv4Address   []string                             `path:"ipv4-address" module:"network-device"`
+	IsManagement  YANGEmpty                            `path:"is-management" module:"network-device"`
+	LoadFactor    *float64                             `path:"load-factor" module:"network-device"`
+	MacAddress    *string                              `path:"mac-address" module:"network-device"`
+	Mtu           *uint16                              `path:"mtu" module:"network-device"`
+	Name          *string                              `path:"name" module:"network-device"`
+	Password      *string                              `path:"password" module:"network-device"`
+	Priority      *uint8                               `path:"priority" module:"network-device"`
+	Speed         E_NetworkDevice_Interface_Speed      `path:"speed" module:"network-device"`
+	State         *NetworkDevice_Interface_State       `path:"state" module:"network-device"`
+	Status        NetworkDevice_Interface_Status_Union `path:"status" module:"network-device-extensions"`
+	Tags          *NetworkDevice_Interface_Tags        `path:"tags" module:"network-device"`
+	Tunnel        *NetworkDevice_Interface_Tunnel      `path:"tunnel" module:"network-device" yangPresence:"true"`
+	VlanId        *uint16                              `path:"vlan-id" module:"network-device"`
 }
 
 // IsYANGGoStruct ensures that NetworkDevice_Interface implements the yang.GoStruct
@@ -199,6 +249,387 @@ type NetworkDevice_Interface struct {
 // identify it as being generated by ygen.
 func (*NetworkDevice_Interface) IsYANGGoStruct() {}
 
+// GetOrCreateState retrieves the value of the State field
+// or returns the existing field if it already exists.
+func (t *NetworkDevice_Interface) GetOrCreateState() *NetworkDevice_Interface_State {
+	if t.State != nil {
+		return t.State
+	}
+	t.State = &NetworkDevice_Interface_State{}
+	return t.State
+}
+
+// GetOrCreateTags retrieves the value of the Tags field
+// or returns the existing field if it already exists.
+func (t *NetworkDevice_Interface) GetOrCreateTags() *NetworkDevice_Interface_Tags {
+	if t.Tags != nil {
+		return t.Tags
+	}
+	t.Tags = &NetworkDevice_Interface_Tags{}
+	return t.Tags
+}
+
+// GetOrCreateTunnel retrieves the value of the Tunnel field
+// or returns the existing field if it already exists.
+func (t *NetworkDevice_Interface) GetOrCreateTunnel() *NetworkDevice_Interface_Tunnel {
+	if t.Tunnel != nil {
+		return t.Tunnel
+	}
+	t.Tunnel = &NetworkDevice_Interface_Tunnel{}
+	return t.Tunnel
+}
+
+// GetState returns the value of the State struct pointer
+// from NetworkDevice_Interface. If the receiver or the field State is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *NetworkDevice_Interface) GetState() *NetworkDevice_Interface_State {
+	if t != nil && t.State != nil {
+		return t.State
+	}
+	return nil
+}
+
+// GetTags returns the value of the Tags struct pointer
+// from NetworkDevice_Interface. If the receiver or the field Tags is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *NetworkDevice_Interface) GetTags() *NetworkDevice_Interface_Tags {
+	if t != nil && t.Tags != nil {
+		return t.Tags
+	}
+	return nil
+}
+
+// GetTunnel returns the value of the Tunnel struct pointer
+// from NetworkDevice_Interface. If the receiver or the field Tunnel is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *NetworkDevice_Interface) GetTunnel() *NetworkDevice_Interface_Tunnel {
+	if t != nil && t.Tunnel != nil {
+		return t.Tunnel
+	}
+	return nil
+}
+
+// GetAutoNegotiate retrieves the value of the leaf AutoNegotiate from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if AutoNegotiate is set, it can
+// safely use t.GetAutoNegotiate() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.AutoNegotiate == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetAutoNegotiate() bool {
+	if t == nil || t.AutoNegotiate == nil {
+		return false
+	}
+	return *t.AutoNegotiate
+}
+
+// GetBandwidth retrieves the value of the leaf Bandwidth from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Bandwidth is set, it can
+// safely use t.GetBandwidth() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Bandwidth == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetBandwidth() uint32 {
+	if t == nil || t.Bandwidth == nil {
+		return 0
+	}
+	return *t.Bandwidth
+}
+
+// GetCookie retrieves the value of the leaf Cookie from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Cookie is set, it can
+// safely use t.GetCookie() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Cookie == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetCookie() Binary {
+	if t == nil || t.Cookie == nil {
+		return nil
+	}
+	return t.Cookie
+}
+
+// GetDescription retrieves the value of the leaf Description from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Description is set, it can
+// safely use t.GetDescription() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Description == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetDescription() string {
+	if t == nil || t.Description == nil {
+		return ""
+	}
+	return *t.Description
+}
+
+// GetDhcp retrieves the value of the leaf Dhcp from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Dhcp is set, it can
+// safely use t.GetDhcp() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Dhcp == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetDhcp() bool {
+	if t == nil || t.Dhcp == nil {
+		return false
+	}
+	return *t.Dhcp
+}
+
+// GetDuplex retrieves the value of the leaf Duplex from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Duplex is set, it can
+// safely use t.GetDuplex() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Duplex == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetDuplex() E_NetworkDevice_Interface_Duplex {
+	if t == nil || t.Duplex == 0 {
+		return 0
+	}
+	return t.Duplex
+}
+
+// GetEnabled retrieves the value of the leaf Enabled from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Enabled is set, it can
+// safely use t.GetEnabled() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Enabled == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return true
+	}
+	return *t.Enabled
+}
+
+// GetInterfaceType retrieves the value of the leaf InterfaceType from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InterfaceType is set, it can
+// safely use t.GetInterfaceType() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InterfaceType == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetInterfaceType() E_NetworkDevice_InterfaceType {
+	if t == nil || t.InterfaceType == 0 {
+		return 0
+	}
+	return t.InterfaceType
+}
+
+// GetIpv4Address retrieves the value of the leaf Ipv4Address from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Ipv4Address is set, it can
+// safely use t.GetIpv4Address() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Ipv4Address == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetIpv4Address() []string {
+	if t == nil || t.Ipv4Address == nil {
+		return nil
+	}
+	return t.Ipv4Address
+}
+
+// GetIsManagement retrieves the value of the leaf IsManagement from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if IsManagement is set, it can
+// safely use t.GetIsManagement() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.IsManagement == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetIsManagement() YANGEmpty {
+	if t == nil || t.IsManagement == false {
+		return false
+	}
+	return t.IsManagement
+}
+
+// GetLoadFactor retrieves the value of the leaf LoadFactor from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if LoadFactor is set, it can
+// safely use t.GetLoadFactor() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.LoadFactor == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetLoadFactor() float64 {
+	if t == nil || t.LoadFactor == nil {
+		return 0.0
+	}
+	return *t.LoadFactor
+}
+
+// GetMacAddress retrieves the value of the leaf MacAddress from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if MacAddress is set, it can
+// safely use t.GetMacAddress() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.MacAddress == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetMacAddress() string {
+	if t == nil || t.MacAddress == nil {
+		return ""
+	}
+	return *t.MacAddress
+}
+
+// GetMtu retrieves the value of the leaf Mtu from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Mtu is set, it can
+// safely use t.GetMtu() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Mtu == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetMtu() uint16 {
+	if t == nil || t.Mtu == nil {
+		return 0
+	}
+	return *t.Mtu
+}
+
+// GetName retrieves the value of the leaf Name from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Name is set, it can
+// safely use t.GetName() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Name == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetName() string {
+	if t == nil || t.Name == nil {
+		return ""
+	}
+	return *t.Name
+}
+
+// GetPassword retrieves the value of the leaf Password from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Password is set, it can
+// safely use t.GetPassword() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Password == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetPassword() string {
+	if t == nil || t.Password == nil {
+		return ""
+	}
+	return *t.Password
+}
+
+// GetPriority retrieves the value of the leaf Priority from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Priority is set, it can
+// safely use t.GetPriority() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Priority == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetPriority() uint8 {
+	if t == nil || t.Priority == nil {
+		return 0
+	}
+	return *t.Priority
+}
+
+// GetSpeed retrieves the value of the leaf Speed from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Speed is set, it can
+// safely use t.GetSpeed() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Speed == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetSpeed() E_NetworkDevice_Interface_Speed {
+	if t == nil || t.Speed == 0 {
+		return 0
+	}
+	return t.Speed
+}
+
+// GetStatus retrieves the value of the leaf Status from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Status is set, it can
+// safely use t.GetStatus() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Status == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetStatus() NetworkDevice_Interface_Status_Union {
+	if t == nil || t.Status == nil {
+		return nil
+	}
+	return t.Status
+}
+
+// GetVlanId retrieves the value of the leaf VlanId from the NetworkDevice_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if VlanId is set, it can
+// safely use t.GetVlanId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.VlanId == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface) GetVlanId() uint16 {
+	if t == nil || t.VlanId == nil {
+		return 0
+	}
+	return *t.VlanId
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the NetworkDevice_Interface
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *NetworkDevice_Interface) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.Enabled == nil {
+		var v bool = true
+		t.Enabled = &v
+	}
+	t.State.PopulateDefaults()
+	t.Tags.PopulateDefaults()
+	t.Tunnel.PopulateDefaults()
+}
+
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkDevice_Interface) ΛValidate(opts ...ygot.ValidationOption) error {
 	if err := ytypes.Validate(SchemaTree["NetworkDevice_Interface"], t, opts...); err != nil {
@@ -252,6 +683,623 @@ func (t *NetworkDevice_Interface) To_NetworkDevice_Interface_Status_Union(i inte
 	return nil, fmt.Errorf("cannot convert %v to NetworkDevice_Interface_Status_Union, unknown union type, got: %T, want any of [E_NetworkDevice_Interface_Status, string]", i, i)
 }
 
+// NetworkDevice_Interface_State represents the /network-device/interface/state YANG schema element.
+type NetworkDevice_Interface_State struct {
+	Counters *NetworkDevice_Interface_State_Counters `path:"counters" module:"network-device"`
+	JumboMtu *uint16                                 `path:"jumbo-mtu" module:"network-device"`
+}
+
+// IsYANGGoStruct ensures that NetworkDevice_Interface_State implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*NetworkDevice_Interface_State) IsYANGGoStruct() {}
+
+// GetOrCreateCounters retrieves the value of the Counters field
+// or returns the existing field if it already exists.
+func (t *NetworkDevice_Interface_State) GetOrCreateCounters() *NetworkDevice_Interface_State_Counters {
+	if t.Counters != nil {
+		return t.Counters
+	}
+	t.Counters = &NetworkDevice_Interface_State_Counters{}
+	return t.Counters
+}
+
+// GetCounters returns the value of the Counters struct pointer
+// from NetworkDevice_Interface_State. If the receiver or the field Counters is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *NetworkDevice_Interface_State) GetCounters() *NetworkDevice_Interface_State_Counters {
+	if t != nil && t.Counters != nil {
+		return t.Counters
+	}
+	return nil
+}
+
+// GetJumboMtu retrieves the value of the leaf JumboMtu from the NetworkDevice_Interface_State
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if JumboMtu is set, it can
+// safely use t.GetJumboMtu() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.JumboMtu == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface_State) GetJumboMtu() uint16 {
+	if t == nil || t.JumboMtu == nil {
+		return 0
+	}
+	return *t.JumboMtu
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the NetworkDevice_Interface_State
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *NetworkDevice_Interface_State) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	t.Counters.PopulateDefaults()
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_Interface_State) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["NetworkDevice_Interface_State"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_Interface_State) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *NetworkDevice_Interface_State) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of NetworkDevice_Interface_State.
+func (*NetworkDevice_Interface_State) ΛBelongingModule() string {
+	return "network-device"
+}
+
+// NetworkDevice_Interface_State_Counters represents the /network-device/interface/state/counters YANG schema element.
+type NetworkDevice_Interface_State_Counters struct {
+	InOctets  *uint64 `path:"in-octets" module:"network-device"`
+	OutOctets *uint64 `path:"out-octets" module:"network-device"`
+}
+
+// IsYANGGoStruct ensures that NetworkDevice_Interface_State_Counters implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*NetworkDevice_Interface_State_Counters) IsYANGGoStruct() {}
+
+// GetInOctets retrieves the value of the leaf InOctets from the NetworkDevice_Interface_State_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InOctets is set, it can
+// safely use t.GetInOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InOctets == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface_State_Counters) GetInOctets() uint64 {
+	if t == nil || t.InOctets == nil {
+		return 0
+	}
+	return *t.InOctets
+}
+
+// GetOutOctets retrieves the value of the leaf OutOctets from the NetworkDevice_Interface_State_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutOctets is set, it can
+// safely use t.GetOutOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutOctets == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface_State_Counters) GetOutOctets() uint64 {
+	if t == nil || t.OutOctets == nil {
+		return 0
+	}
+	return *t.OutOctets
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the NetworkDevice_Interface_State_Counters
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *NetworkDevice_Interface_State_Counters) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_Interface_State_Counters) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["NetworkDevice_Interface_State_Counters"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_Interface_State_Counters) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *NetworkDevice_Interface_State_Counters) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of NetworkDevice_Interface_State_Counters.
+func (*NetworkDevice_Interface_State_Counters) ΛBelongingModule() string {
+	return "network-device"
+}
+
+// NetworkDevice_Interface_Tags represents the /network-device/interface/tags YANG schema element.
+type NetworkDevice_Interface_Tags struct {
+	Tag map[string]*NetworkDevice_Interface_Tags_Tag `path:"tag" module:"network-device"`
+}
+
+// IsYANGGoStruct ensures that NetworkDevice_Interface_Tags implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*NetworkDevice_Interface_Tags) IsYANGGoStruct() {}
+
+// NewTag creates a new entry in the Tag list of the
+// NetworkDevice_Interface_Tags struct. The keys of the list are populated from the input
+// arguments.
+func (t *NetworkDevice_Interface_Tags) NewTag(Key string) (*NetworkDevice_Interface_Tags_Tag, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Tag == nil {
+		t.Tag = make(map[string]*NetworkDevice_Interface_Tags_Tag)
+	}
+
+	key := Key
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Tag[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Tag", key)
+	}
+
+	t.Tag[key] = &NetworkDevice_Interface_Tags_Tag{
+		Key: &Key,
+	}
+
+	return t.Tag[key], nil
+}
+
+// GetOrCreateTagMap returns the list (map) from NetworkDevice_Interface_Tags.
+//
+// It initializes the field if not already initialized.
+func (t *NetworkDevice_Interface_Tags) GetOrCreateTagMap() map[string]*NetworkDevice_Interface_Tags_Tag {
+	if t.Tag == nil {
+		t.Tag = make(map[string]*NetworkDevice_Interface_Tags_Tag)
+	}
+	return t.Tag
+}
+
+// GetOrCreateTag retrieves the value with the specified keys from
+// the receiver NetworkDevice_Interface_Tags. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *NetworkDevice_Interface_Tags) GetOrCreateTag(Key string) *NetworkDevice_Interface_Tags_Tag {
+
+	key := Key
+
+	if v, ok := t.Tag[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewTag(Key)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateTag got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetTag retrieves the value with the specified key from
+// the Tag map field of NetworkDevice_Interface_Tags. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *NetworkDevice_Interface_Tags) GetTag(Key string) *NetworkDevice_Interface_Tags_Tag {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Key
+
+	if lm, ok := t.Tag[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the NetworkDevice_Interface_Tags
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *NetworkDevice_Interface_Tags) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	for _, e := range t.Tag {
+		e.PopulateDefaults()
+	}
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_Interface_Tags) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["NetworkDevice_Interface_Tags"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_Interface_Tags) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *NetworkDevice_Interface_Tags) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of NetworkDevice_Interface_Tags.
+func (*NetworkDevice_Interface_Tags) ΛBelongingModule() string {
+	return "network-device"
+}
+
+// NetworkDevice_Interface_Tags_Tag represents the /network-device/interface/tags/tag YANG schema element.
+type NetworkDevice_Interface_Tags_Tag struct {
+	Key   *string `path:"key" module:"network-device"`
+	Value *string `path:"value" module:"network-device"`
+}
+
+// IsYANGGoStruct ensures that NetworkDevice_Interface_Tags_Tag implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*NetworkDevice_Interface_Tags_Tag) IsYANGGoStruct() {}
+
+// GetKey retrieves the value of the leaf Key from the NetworkDevice_Interface_Tags_Tag
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Key is set, it can
+// safely use t.GetKey() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Key == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface_Tags_Tag) GetKey() string {
+	if t == nil || t.Key == nil {
+		return ""
+	}
+	return *t.Key
+}
+
+// GetValue retrieves the value of the leaf Value from the NetworkDevice_Interface_Tags_Tag
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Value is set, it can
+// safely use t.GetValue() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Value == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface_Tags_Tag) GetValue() string {
+	if t == nil || t.Value == nil {
+		return ""
+	}
+	return *t.Value
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the NetworkDevice_Interface_Tags_Tag
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *NetworkDevice_Interface_Tags_Tag) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛListKeyMap returns the keys of the NetworkDevice_Interface_Tags_Tag struct, which is a YANG list entry.
+func (t *NetworkDevice_Interface_Tags_Tag) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Key == nil {
+		return nil, fmt.Errorf("nil value for key Key")
+	}
+
+	return map[string]interface{}{
+		"key": *t.Key,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_Interface_Tags_Tag) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["NetworkDevice_Interface_Tags_Tag"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_Interface_Tags_Tag) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *NetworkDevice_Interface_Tags_Tag) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of NetworkDevice_Interface_Tags_Tag.
+func (*NetworkDevice_Interface_Tags_Tag) ΛBelongingModule() string {
+	return "network-device"
+}
+
+// NetworkDevice_Interface_Tunnel represents the /network-device/interface/tunnel YANG schema element.
+type NetworkDevice_Interface_Tunnel struct {
+	RemoteAddress *string `path:"remote-address" module:"network-device"`
+}
+
+// IsYANGGoStruct ensures that NetworkDevice_Interface_Tunnel implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*NetworkDevice_Interface_Tunnel) IsYANGGoStruct() {}
+
+// GetRemoteAddress retrieves the value of the leaf RemoteAddress from the NetworkDevice_Interface_Tunnel
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if RemoteAddress is set, it can
+// safely use t.GetRemoteAddress() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.RemoteAddress == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_Interface_Tunnel) GetRemoteAddress() string {
+	if t == nil || t.RemoteAddress == nil {
+		return ""
+	}
+	return *t.RemoteAddress
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the NetworkDevice_Interface_Tunnel
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *NetworkDevice_Interface_Tunnel) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_Interface_Tunnel) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["NetworkDevice_Interface_Tunnel"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_Interface_Tunnel) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *NetworkDevice_Interface_Tunnel) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of NetworkDevice_Interface_Tunnel.
+func (*NetworkDevice_Interface_Tunnel) ΛBelongingModule() string {
+	return "network-device"
+}
+
+// NetworkDevice_System represents the /network-device/system YANG schema element.
+type NetworkDevice_System struct {
+	DefaultMtu *uint16  `path:"default-mtu" module:"network-device"`
+	DnsServer  []string `path:"dns-server" module:"network-device"`
+	NtpServer  []string `path:"ntp-server" module:"network-device"`
+}
+
+// IsYANGGoStruct ensures that NetworkDevice_System implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*NetworkDevice_System) IsYANGGoStruct() {}
+
+// GetDefaultMtu retrieves the value of the leaf DefaultMtu from the NetworkDevice_System
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if DefaultMtu is set, it can
+// safely use t.GetDefaultMtu() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.DefaultMtu == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_System) GetDefaultMtu() uint16 {
+	if t == nil || t.DefaultMtu == nil {
+		return 0
+	}
+	return *t.DefaultMtu
+}
+
+// GetDnsServer retrieves the value of the leaf DnsServer from the NetworkDevice_System
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if DnsServer is set, it can
+// safely use t.GetDnsServer() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.DnsServer == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_System) GetDnsServer() []string {
+	if t == nil || t.DnsServer == nil {
+		return nil
+	}
+	return t.DnsServer
+}
+
+// GetNtpServer retrieves the value of the leaf NtpServer from the NetworkDevice_System
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if NtpServer is set, it can
+// safely use t.GetNtpServer() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.NtpServer == nil' before retrieving the leaf's value.
+func (t *NetworkDevice_System) GetNtpServer() []string {
+	if t == nil || t.NtpServer == nil {
+		return nil
+	}
+	return t.NtpServer
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the NetworkDevice_System
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *NetworkDevice_System) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_System) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["NetworkDevice_System"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *NetworkDevice_System) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *NetworkDevice_System) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of NetworkDevice_System.
+func (*NetworkDevice_System) ΛBelongingModule() string {
+	return "network-device"
+}
+
+// E_NetworkDevice_InterfaceType is a derived int64 type which is used to represent
+// the enumerated node NetworkDevice_InterfaceType. An additional value named
+// NetworkDevice_InterfaceType_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_NetworkDevice_InterfaceType int64
+
+// IsYANGGoEnum ensures that NetworkDevice_InterfaceType implements the yang.GoEnum
+// interface. This ensures that NetworkDevice_InterfaceType can be identified as a
+// mapped type for a YANG enumeration.
+func (E_NetworkDevice_InterfaceType) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  NetworkDevice_InterfaceType.
+func (E_NetworkDevice_InterfaceType) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+// String returns a logging-friendly string for E_NetworkDevice_InterfaceType.
+func (e E_NetworkDevice_InterfaceType) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_NetworkDevice_InterfaceType")
+}
+
+const (
+	// NetworkDevice_InterfaceType_UNSET corresponds to the value UNSET of NetworkDevice_InterfaceType
+	NetworkDevice_InterfaceType_UNSET E_NetworkDevice_InterfaceType = 0
+	// NetworkDevice_InterfaceType_ethernet corresponds to the value ethernet of NetworkDevice_InterfaceType
+	NetworkDevice_InterfaceType_ethernet E_NetworkDevice_InterfaceType = 1
+	// NetworkDevice_InterfaceType_wifi corresponds to the value wifi of NetworkDevice_InterfaceType
+	NetworkDevice_InterfaceType_wifi E_NetworkDevice_InterfaceType = 2
+)
+
+// E_NetworkDevice_Interface_Duplex is a derived int64 type which is used to represent
+// the enumerated node NetworkDevice_Interface_Duplex. An additional value named
+// NetworkDevice_Interface_Duplex_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_NetworkDevice_Interface_Duplex int64
+
+// IsYANGGoEnum ensures that NetworkDevice_Interface_Duplex implements the yang.GoEnum
+// interface. This ensures that NetworkDevice_Interface_Duplex can be identified as a
+// mapped type for a YANG enumeration.
+func (E_NetworkDevice_Interface_Duplex) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  NetworkDevice_Interface_Duplex.
+func (E_NetworkDevice_Interface_Duplex) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_NetworkDevice_Interface_Duplex.
+func (e E_NetworkDevice_Interface_Duplex) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_NetworkDevice_Interface_Duplex")
+}
+
+const (
+	// NetworkDevice_Interface_Duplex_UNSET corresponds to the value UNSET of NetworkDevice_Interface_Duplex
+	NetworkDevice_Interface_Duplex_UNSET E_NetworkDevice_Interface_Duplex = 0
+	// NetworkDevice_Interface_Duplex_half corresponds to the value half of NetworkDevice_Interface_Duplex
+	NetworkDevice_Interface_Duplex_half E_NetworkDevice_Interface_Duplex = 1
+	// NetworkDevice_Interface_Duplex_full corresponds to the value full of NetworkDevice_Interface_Duplex
+	NetworkDevice_Interface_Duplex_full E_NetworkDevice_Interface_Duplex = 2
+)
+
+// E_NetworkDevice_Interface_Speed is a derived int64 type which is used to represent
+// the enumerated node NetworkDevice_Interface_Speed. An additional value named
+// NetworkDevice_Interface_Speed_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_NetworkDevice_Interface_Speed int64
+
+// IsYANGGoEnum ensures that NetworkDevice_Interface_Speed implements the yang.GoEnum
+// interface. This ensures that NetworkDevice_Interface_Speed can be identified as a
+// mapped type for a YANG enumeration.
+func (E_NetworkDevice_Interface_Speed) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  NetworkDevice_Interface_Speed.
+func (E_NetworkDevice_Interface_Speed) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_NetworkDevice_Interface_Speed.
+func (e E_NetworkDevice_Interface_Speed) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_NetworkDevice_Interface_Speed")
+}
+
+const (
+	// NetworkDevice_Interface_Speed_UNSET corresponds to the value UNSET of NetworkDevice_Interface_Speed
+	NetworkDevice_Interface_Speed_UNSET E_NetworkDevice_Interface_Speed = 0
+	// NetworkDevice_Interface_Speed_10M corresponds to the value 10M of NetworkDevice_Interface_Speed
+	NetworkDevice_Interface_Speed_10M E_NetworkDevice_Interface_Speed = 1
+	// NetworkDevice_Interface_Speed_100M corresponds to the value 100M of NetworkDevice_Interface_Speed
+	NetworkDevice_Interface_Speed_100M E_NetworkDevice_Interface_Speed = 2
+	// NetworkDevice_Interface_Speed_1G corresponds to the value 1G of NetworkDevice_Interface_Speed
+	NetworkDevice_Interface_Speed_1G E_NetworkDevice_Interface_Speed = 3
+	// NetworkDevice_Interface_Speed_10G corresponds to the value 10G of NetworkDevice_Interface_Speed
+	NetworkDevice_Interface_Speed_10G E_NetworkDevice_Interface_Speed = 4
+)
+
 // E_NetworkDevice_Interface_Status is a derived int64 type which is used to represent
 // the enumerated node NetworkDevice_Interface_Status. An additional value named
 // NetworkDevice_Interface_Status_UNSET is added to the enumeration which is used as
@@ -283,6 +1331,8 @@ const (
 	NetworkDevice_Interface_Status_down E_NetworkDevice_Interface_Status = 2
 	// NetworkDevice_Interface_Status_testing corresponds to the value testing of NetworkDevice_Interface_Status
 	NetworkDevice_Interface_Status_testing E_NetworkDevice_Interface_Status = 3
+	// NetworkDevice_Interface_Status_dormant corresponds to the value dormant of NetworkDevice_Interface_Status
+	NetworkDevice_Interface_Status_dormant E_NetworkDevice_Interface_Status = 4
 )
 
 // ΛEnum is a map, keyed by the name of the type defined for each enum in the
@@ -291,10 +1341,25 @@ const (
 // in the YANG schema. The map is named ΛEnum in order to avoid clash with any
 // valid YANG identifier.
 var ΛEnum = map[string]map[int64]ygot.EnumDefinition{
+	"E_NetworkDevice_InterfaceType": {
+		1: {Name: "ethernet", DefiningModule: "network-device"},
+		2: {Name: "wifi", DefiningModule: "network-device"},
+	},
+	"E_NetworkDevice_Interface_Duplex": {
+		1: {Name: "half"},
+		2: {Name: "full"},
+	},
+	"E_NetworkDevice_Interface_Speed": {
+		1: {Name: "10M"},
+		2: {Name: "100M"},
+		3: {Name: "1G"},
+		4: {Name: "10G"},
+	},
 	"E_NetworkDevice_Interface_Status": {
 		1: {Name: "up"},
 		2: {Name: "down"},
 		3: {Name: "testing"},
+		4: {Name: "dormant"},
 	},
 }
 
@@ -306,67 +1371,216 @@ var (
 	// contents of a goyang yang.Entry struct, which defines the schema for the
 	// fields within the struct.
 	ySchema = []byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xdf, 0x4f, 0xdb, 0x3e,
-		0x10, 0x7f, 0xef, 0x5f, 0x61, 0xf9, 0xf1, 0xfb, 0x4d, 0x47, 0xda, 0x8d, 0xb2, 0xe6, 0x0d, 0x8d,
-		0xa1, 0xa1, 0x09, 0x84, 0x00, 0xed, 0x05, 0x55, 0x93, 0x49, 0xaf, 0xc1, 0xa2, 0x75, 0x22, 0xc7,
-		0xa1, 0xa0, 0x2d, 0xff, 0xfb, 0x14, 0x27, 0x4d, 0x49, 0xf3, 0xcb, 0x71, 0x80, 0xb6, 0x22, 0x6f,
-		0x60, 0xdf, 0xd9, 0xe7, 0xbb, 0xeb, 0xe7, 0xee, 0x63, 0xe7, 0x4f, 0x0f, 0x21, 0x84, 0xf0, 0x05,
-		0x59, 0x00, 0xb6, 0x10, 0x9e, 0xc2, 0x23, 0xb5, 0x01, 0x1b, 0xf1, 0xe8, 0x4f, 0xca, 0xa6, 0xd8,
-		0x42, 0x83, 0xe4, 0xdf, 0x6f, 0x2e, 0x9b, 0x51, 0x07, 0x5b, 0xc8, 0x4c, 0x06, 0x4e, 0x28, 0xc7,
-		0x16, 0x8a, 0x97, 0x90, 0x03, 0x94, 0x09, 0xe0, 0x33, 0x62, 0x43, 0x66, 0x38, 0xb3, 0xc3, 0x5a,
-		0xc4, 0xc8, 0x0a, 0x64, 0x37, 0x4b, 0x87, 0x37, 0x37, 0x4d, 0x27, 0x2e, 0x39, 0xcc, 0xe8, 0x53,
-		0x6e, 0xa3, 0xcc, 0x66, 0x0c, 0xc4, 0xc6, 0x36, 0x72, 0xfa, 0xda, 0x0d, 0x78, 0x81, 0x8d, 0x6b,
-		0x53, 0xe0, 0x79, 0xe9, 0xf2, 0xc8, 0x1a, 0xec, 0xc5, 0xbb, 0x18, 0xc5, 0x82, 0x3f, 0x88, 0x7f,
-		0xcc, 0x9d, 0x60, 0x01, 0x4c, 0x60, 0x0b, 0x09, 0x1e, 0x40, 0x89, 0xe0, 0x0b, 0x29, 0x69, 0x54,
-		0x4e, 0x2a, 0xcc, 0x8c, 0x84, 0x1b, 0x67, 0xdd, 0x74, 0x74, 0x3a, 0x71, 0x47, 0xd8, 0x74, 0x49,
-		0xa7, 0xe2, 0xbe, 0xfc, 0x30, 0x2b, 0x5f, 0xac, 0x45, 0x4b, 0x6c, 0x4c, 0x02, 0x60, 0x96, 0x4c,
-		0x97, 0x05, 0x42, 0x25, 0x20, 0x45, 0x81, 0xe9, 0xc3, 0x93, 0x28, 0x31, 0x45, 0x25, 0x48, 0x8d,
-		0x83, 0xd5, 0x38, 0x68, 0x65, 0xc1, 0x93, 0x86, 0x97, 0x6a, 0x84, 0x85, 0x33, 0x61, 0x89, 0xcf,
-		0x6e, 0x9e, 0x3d, 0x50, 0xf3, 0x58, 0x40, 0x99, 0xf8, 0x3c, 0xac, 0x72, 0x58, 0x12, 0xbf, 0xa3,
-		0x0a, 0x91, 0x2b, 0xc2, 0x9c, 0x68, 0xb5, 0xdb, 0xca, 0x03, 0x57, 0x3b, 0x5c, 0x2e, 0x74, 0x4e,
-		0x59, 0x6d, 0x64, 0x52, 0xe1, 0x5f, 0x64, 0x1e, 0x40, 0xfe, 0xa7, 0x5d, 0x2a, 0x7f, 0xca, 0x89,
-		0x2d, 0xa8, 0xcb, 0x4e, 0xa8, 0x43, 0x85, 0x5f, 0x9e, 0x71, 0x79, 0x5f, 0x81, 0x43, 0x04, 0x7d,
-		0x8c, 0xf6, 0x9a, 0x91, 0xb9, 0x0f, 0xb5, 0x5a, 0xa1, 0xa1, 0x70, 0x54, 0xf2, 0xa4, 0x71, 0x54,
-		0xd3, 0x34, 0xcd, 0xdd, 0x3b, 0x6e, 0x4f, 0x6f, 0x76, 0xd2, 0x53, 0x93, 0x2f, 0x70, 0x27, 0x5e,
-		0x88, 0xa0, 0x1e, 0x9b, 0x22, 0xa1, 0xdd, 0x40, 0xa5, 0xbd, 0x44, 0xa4, 0xed, 0xa0, 0xd1, 0x60,
-		0xa4, 0x80, 0x46, 0xa3, 0x9d, 0x45, 0xa3, 0xd1, 0xd7, 0x8f, 0x03, 0x47, 0xe3, 0xe1, 0x60, 0xd4,
-		0xa1, 0x11, 0x42, 0x98, 0xc5, 0xf9, 0x5b, 0x03, 0x47, 0x52, 0xaa, 0xc3, 0xa3, 0x3d, 0xc2, 0x23,
-		0x5f, 0x70, 0xca, 0x1c, 0x05, 0x3c, 0x1a, 0x54, 0xfc, 0xea, 0xf1, 0x25, 0x11, 0x02, 0x38, 0xab,
-		0x85, 0x24, 0x0c, 0xe2, 0xfe, 0xd6, 0xec, 0x8f, 0x27, 0xff, 0xff, 0x5d, 0xce, 0x09, 0x8b, 0xff,
-		0xc4, 0x6f, 0x92, 0xb0, 0x1e, 0xa7, 0x2e, 0xa7, 0xe2, 0xb9, 0x3e, 0x69, 0x53, 0xc9, 0x2e, 0x71,
-		0xf7, 0x28, 0x71, 0x57, 0x51, 0xeb, 0xcf, 0xe1, 0x11, 0xe6, 0x0a, 0x09, 0x7c, 0xd8, 0xb5, 0xf7,
-		0xdb, 0xaf, 0xa7, 0x87, 0xfb, 0x56, 0x4c, 0x8d, 0xed, 0x64, 0x84, 0xf9, 0x81, 0x18, 0xdf, 0x61,
-		0xd7, 0x60, 0xa1, 0xa8, 0x10, 0x13, 0x11, 0xf8, 0xf5, 0xd5, 0x2a, 0x91, 0xeb, 0xae, 0xa2, 0xf6,
-		0xf1, 0x2a, 0x8a, 0x51, 0x97, 0xa9, 0xf4, 0x5a, 0xe3, 0x0a, 0x99, 0x64, 0xbb, 0xd6, 0xa5, 0x6a,
-		0x65, 0x14, 0xb0, 0x60, 0x01, 0x9c, 0x88, 0x6a, 0xd3, 0x72, 0x26, 0x7e, 0x51, 0x90, 0xfd, 0xce,
-		0x82, 0x85, 0x3a, 0x20, 0xdc, 0xb8, 0xd7, 0x71, 0x33, 0xaa, 0xaa, 0x21, 0xb5, 0x4c, 0xe9, 0x58,
-		0x0f, 0x1b, 0xea, 0x2a, 0x03, 0x79, 0x61, 0xef, 0x2e, 0x59, 0x13, 0xa5, 0x61, 0xa4, 0x24, 0xc0,
-		0x17, 0x91, 0x85, 0x4a, 0x6a, 0xa1, 0xa1, 0x7a, 0xee, 0x33, 0x99, 0x97, 0x0d, 0x0e, 0x2d, 0x8d,
-		0x57, 0x6e, 0x1c, 0xa4, 0xca, 0xca, 0x74, 0x0b, 0x0d, 0x1b, 0x68, 0x05, 0x5e, 0x04, 0x16, 0x6a,
-		0xc7, 0xdd, 0x7a, 0xa9, 0x55, 0xe6, 0x34, 0x4d, 0xb8, 0x4d, 0x63, 0x8e, 0xb3, 0xbe, 0xc0, 0x23,
-		0x94, 0x09, 0x60, 0x84, 0xd9, 0xd0, 0xff, 0xf4, 0x5f, 0x7d, 0xce, 0x4c, 0xde, 0xba, 0xea, 0x54,
-		0xbe, 0x94, 0x1c, 0x07, 0x4e, 0x84, 0x8f, 0x30, 0x2d, 0x3c, 0x60, 0x4d, 0x51, 0x3a, 0x60, 0x20,
-		0xac, 0xb2, 0x07, 0xaa, 0x9c, 0xb7, 0xbb, 0xe2, 0xf4, 0x7e, 0xc5, 0xa9, 0xec, 0x01, 0x2c, 0x15,
-		0xa8, 0x7f, 0x08, 0xcb, 0xf9, 0xb6, 0xee, 0x41, 0x6c, 0xbd, 0x39, 0xf8, 0x36, 0xa7, 0x9e, 0x2c,
-		0x2d, 0x16, 0xc2, 0x67, 0xab, 0x04, 0x41, 0xe9, 0x0a, 0x88, 0x32, 0x74, 0x0e, 0x0e, 0xb9, 0xa3,
-		0xc2, 0x47, 0x1e, 0x70, 0xe4, 0x83, 0xed, 0xb2, 0x69, 0xdd, 0xc2, 0xd5, 0x6d, 0x8e, 0x72, 0x46,
-		0x35, 0xc9, 0x2c, 0x8d, 0x0c, 0x6b, 0x9a, 0x69, 0xda, 0x19, 0xa7, 0x9d, 0x79, 0x7a, 0x19, 0xf8,
-		0x2a, 0x78, 0x5e, 0xdf, 0x36, 0x15, 0xde, 0x9d, 0x57, 0xbe, 0xe4, 0x6d, 0xe6, 0xc7, 0x91, 0x82,
-		0xa8, 0x1a, 0xf5, 0x57, 0xaf, 0x42, 0x5a, 0xc4, 0x4f, 0xf7, 0x4a, 0xa0, 0x35, 0x37, 0xd2, 0xe7,
-		0x48, 0x0d, 0xbb, 0x9c, 0xc6, 0xfc, 0xb0, 0x80, 0x13, 0x2b, 0xbf, 0x0c, 0xee, 0x82, 0x5b, 0x5e,
-		0xa9, 0x67, 0xd2, 0x6d, 0x0a, 0x2a, 0xc2, 0x52, 0x47, 0x35, 0x9b, 0x51, 0xce, 0x7a, 0xb0, 0x77,
-		0xbd, 0x84, 0x5e, 0x90, 0x39, 0x52, 0x5b, 0xaa, 0x83, 0xf7, 0x0f, 0x09, 0xef, 0xac, 0x21, 0x05,
-		0x1d, 0x2b, 0xc8, 0x2a, 0xb1, 0x65, 0x0d, 0x74, 0xd7, 0x63, 0xcf, 0x3a, 0x2c, 0x5a, 0x8f, 0x4d,
-		0xb7, 0x63, 0xd5, 0x2d, 0xd8, 0x75, 0x2b, 0x96, 0xdd, 0x82, 0x6d, 0x6b, 0xd4, 0x23, 0x4d, 0xf6,
-		0xdd, 0x82, 0x85, 0xb7, 0x63, 0xe3, 0x3a, 0xac, 0xbc, 0x59, 0x3d, 0x6a, 0x50, 0xb9, 0x8c, 0xb7,
-		0xfa, 0x45, 0x29, 0xb3, 0x77, 0x1d, 0x16, 0xaf, 0xcd, 0xe6, 0xb5, 0x59, 0xbd, 0x5a, 0x21, 0x7f,
-		0x87, 0x86, 0x40, 0xeb, 0x96, 0x60, 0xb2, 0x79, 0x4b, 0xc0, 0x98, 0x2b, 0x48, 0x52, 0xe3, 0x0b,
-		0x3e, 0xab, 0xf4, 0xed, 0x7b, 0x58, 0x10, 0x8f, 0x48, 0x3a, 0x29, 0xaf, 0x05, 0x96, 0x2e, 0x7f,
-		0xe8, 0xc7, 0x5f, 0xc7, 0x1e, 0x54, 0xdd, 0x10, 0x44, 0x71, 0x0f, 0x6c, 0x91, 0x7c, 0x67, 0x80,
-		0x2f, 0x62, 0xcd, 0x13, 0xa9, 0xf8, 0x3b, 0x6d, 0x26, 0xb2, 0xfe, 0x5e, 0xdb, 0x1a, 0xff, 0x95,
-		0x24, 0x65, 0x99, 0x95, 0x98, 0xfa, 0xa7, 0xe4, 0x01, 0xae, 0x5c, 0x37, 0x5f, 0x3e, 0x37, 0x2d,
-		0xc7, 0x2f, 0xa7, 0x32, 0x96, 0xc5, 0x26, 0xc5, 0x86, 0x84, 0xbd, 0xf0, 0x1f, 0x00, 0x00, 0x00,
-		0xff, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x13, 0x14, 0x0a, 0x08, 0x2c, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5d, 0x5d, 0x73, 0xdb, 0xb6,
+		0xd2, 0xbe, 0xd7, 0xaf, 0xd8, 0xe1, 0x4d, 0x93, 0xf7, 0x15, 0x6d, 0xc9, 0xb1, 0x9d, 0xc4, 0x33,
+		0xe7, 0xc2, 0xad, 0x9b, 0x36, 0xd3, 0x3a, 0xcd, 0x34, 0x4e, 0xcf, 0x45, 0xea, 0xc9, 0xc0, 0xe4,
+		0x4a, 0xc2, 0x09, 0x05, 0xaa, 0x00, 0x68, 0x5b, 0x27, 0xf5, 0x7f, 0x3f, 0x03, 0x7e, 0xe8, 0x5b,
+		0xe4, 0x82, 0x94, 0x6c, 0xa9, 0x01, 0x2f, 0x5a, 0x47, 0x5a, 0x50, 0xf8, 0x78, 0xb0, 0xbb, 0x58,
+		0x3c, 0x58, 0x7c, 0x6d, 0x01, 0x00, 0x78, 0xef, 0xd8, 0x10, 0xbd, 0x33, 0xf0, 0x42, 0xbc, 0xe5,
+		0x01, 0x7a, 0xed, 0xec, 0xd3, 0x5f, 0xb8, 0x08, 0xbd, 0x33, 0xe8, 0xe6, 0xff, 0xfc, 0x21, 0x16,
+		0x3d, 0xde, 0xf7, 0xce, 0xa0, 0x93, 0x7f, 0x70, 0xc1, 0xa5, 0x77, 0x06, 0xd9, 0x2b, 0x00, 0x00,
+		0x3c, 0x2e, 0x34, 0xca, 0x1e, 0x0b, 0x70, 0xee, 0xe3, 0xb9, 0x5f, 0x98, 0x8a, 0xb4, 0xe7, 0x05,
+		0x2e, 0x50, 0x05, 0x92, 0x8f, 0x34, 0x8f, 0x85, 0x91, 0x7b, 0x87, 0xfa, 0x2e, 0x96, 0x5f, 0x60,
+		0x22, 0x0f, 0x41, 0xfa, 0xf3, 0x89, 0x64, 0xa9, 0xc8, 0x42, 0xe9, 0xf9, 0xaa, 0x4e, 0x3e, 0x5e,
+		0xac, 0xf2, 0xe4, 0x8b, 0xf7, 0x12, 0x7b, 0xfc, 0x7e, 0xa9, 0x9a, 0x73, 0x55, 0x15, 0xa8, 0xbd,
+		0xf6, 0xf2, 0xd7, 0x1f, 0xe2, 0x44, 0xae, 0x68, 0xe1, 0xb4, 0x2a, 0x38, 0xbe, 0x8b, 0xa5, 0xa9,
+		0x8d, 0x37, 0xca, 0x7e, 0xa5, 0xbd, 0x5a, 0xf0, 0x67, 0xa6, 0xce, 0x65, 0x3f, 0x19, 0xa2, 0xd0,
+		0xde, 0x19, 0x68, 0x99, 0xe0, 0x1a, 0xc1, 0x19, 0xa9, 0xb4, 0x52, 0x4b, 0x52, 0x0f, 0x73, 0x9f,
+		0x3c, 0x2c, 0xf6, 0xec, 0xc2, 0x30, 0x4d, 0xbe, 0x60, 0x61, 0x28, 0x51, 0x29, 0x7f, 0x18, 0x87,
+		0x25, 0xed, 0x29, 0xba, 0x63, 0x4e, 0x7a, 0x4d, 0x4d, 0x17, 0x06, 0xf1, 0xe7, 0xf8, 0x0e, 0xf4,
+		0x00, 0x67, 0x06, 0xb1, 0x8f, 0x5a, 0x01, 0xd7, 0x0a, 0xde, 0xbe, 0xbf, 0x3d, 0x86, 0xfc, 0x95,
+		0xa8, 0xd6, 0xbd, 0x2f, 0x1f, 0xd6, 0x93, 0x35, 0x5f, 0xaf, 0x1b, 0x5e, 0xca, 0x30, 0x13, 0x87,
+		0x9b, 0x3a, 0xec, 0xd6, 0xc3, 0x6f, 0x0d, 0x03, 0x3a, 0x1c, 0x56, 0xc3, 0x62, 0x0d, 0x3c, 0x2a,
+		0x61, 0x52, 0x3c, 0x5e, 0x38, 0x16, 0x6c, 0xc8, 0x83, 0xea, 0x2e, 0x98, 0x68, 0x93, 0xbc, 0x40,
+		0x45, 0x7b, 0xf2, 0x41, 0x3e, 0xae, 0x10, 0xab, 0x1a, 0x6c, 0x9b, 0x41, 0xb7, 0x1c, 0x7c, 0x5b,
+		0x10, 0xd4, 0x06, 0x43, 0x6d, 0x50, 0xd8, 0x83, 0xa3, 0x1c, 0x24, 0x15, 0x60, 0x21, 0x83, 0x66,
+		0x0a, 0x9e, 0x41, 0x30, 0xa2, 0xf7, 0xdb, 0x04, 0x41, 0xa6, 0x14, 0xb1, 0xe5, 0x0b, 0xba, 0xe7,
+		0x27, 0xd4, 0xc0, 0x44, 0xa1, 0x62, 0xa0, 0x27, 0xe3, 0x21, 0x30, 0xb8, 0xf8, 0xf9, 0x87, 0xf7,
+		0xa0, 0x50, 0xde, 0xa2, 0xa4, 0xbe, 0x37, 0x87, 0x67, 0x87, 0x28, 0x4e, 0x85, 0x69, 0x1d, 0xb8,
+		0xd6, 0x84, 0x6d, 0x5d, 0xf8, 0x36, 0x86, 0x71, 0x63, 0x38, 0xd7, 0x87, 0x35, 0x0d, 0xde, 0x44,
+		0x98, 0x17, 0x8f, 0x77, 0x35, 0x1e, 0x61, 0xbd, 0x91, 0xba, 0x89, 0xe3, 0x08, 0x99, 0xb0, 0x19,
+		0xad, 0xc2, 0xa7, 0xe9, 0xb6, 0x36, 0xd3, 0xd0, 0x66, 0x33, 0xfd, 0x5c, 0x88, 0x58, 0xb3, 0x7c,
+		0x76, 0x11, 0x26, 0xbc, 0x0a, 0x06, 0x38, 0x64, 0x23, 0xa6, 0x07, 0xa6, 0xf9, 0x87, 0x22, 0xf3,
+		0xe7, 0xfc, 0xcc, 0xc3, 0x3c, 0x9c, 0x78, 0x04, 0x87, 0xb3, 0x6e, 0xc5, 0x61, 0x61, 0x31, 0x5a,
+		0xf5, 0xda, 0x51, 0xd2, 0x06, 0x4f, 0x99, 0xca, 0x5b, 0x18, 0xaf, 0x5c, 0xde, 0xd9, 0x2e, 0x67,
+		0xbb, 0xf8, 0xe8, 0xf6, 0xd8, 0xcf, 0x71, 0x6a, 0x6f, 0xc3, 0xe6, 0x4a, 0xd7, 0xb3, 0x65, 0x1f,
+		0x52, 0x2c, 0xb2, 0x28, 0x1a, 0x03, 0x53, 0x8a, 0xf7, 0x05, 0x86, 0x34, 0x07, 0x7a, 0x1d, 0x5e,
+		0x9d, 0x31, 0x73, 0xc6, 0xac, 0x81, 0x31, 0xab, 0x01, 0xe9, 0x59, 0xf4, 0x75, 0x5f, 0x59, 0x94,
+		0x79, 0xcf, 0xb4, 0x46, 0x29, 0xbc, 0x33, 0xf8, 0x64, 0xd7, 0xcb, 0xcf, 0x9e, 0x7d, 0xea, 0xf8,
+		0xaf, 0xaf, 0xff, 0xfe, 0xd4, 0xf5, 0x5f, 0x5f, 0x67, 0x7f, 0x76, 0xd3, 0xff, 0x65, 0x7f, 0x1f,
+		0x7d, 0xea, 0xf8, 0xc7, 0xc5, 0xdf, 0x27, 0x9f, 0x3a, 0xfe, 0xc9, 0xf5, 0xf3, 0x3f, 0xff, 0x3c,
+		0x78, 0xfe, 0xf5, 0xc5, 0x83, 0x7d, 0x41, 0xfa, 0x10, 0x5e, 0x6f, 0x74, 0x08, 0x7f, 0xe5, 0x4a,
+		0x9f, 0x6b, 0x2d, 0xed, 0x86, 0xf1, 0x92, 0x8b, 0x1f, 0x23, 0x34, 0x08, 0x54, 0xf4, 0xa9, 0x9d,
+		0x95, 0x64, 0xf7, 0x33, 0x25, 0xbb, 0xaf, 0x8e, 0x8f, 0x4f, 0x5f, 0x1e, 0x1f, 0x77, 0x5e, 0xbe,
+		0x78, 0xd9, 0x79, 0x7d, 0x72, 0xd2, 0x3d, 0xed, 0x9e, 0x58, 0xbc, 0xec, 0x37, 0x19, 0xa2, 0xc4,
+		0xf0, 0xfb, 0xb1, 0x77, 0x06, 0x22, 0x89, 0xa2, 0x3a, 0x45, 0x3f, 0x2a, 0x34, 0x8d, 0xef, 0xb1,
+		0x48, 0xe1, 0xb7, 0xe3, 0x26, 0xe5, 0xbe, 0x49, 0x5d, 0x2f, 0xc9, 0x2a, 0x2c, 0x40, 0x6c, 0x50,
+		0xad, 0x86, 0x78, 0x2d, 0x5a, 0xfd, 0x56, 0xd4, 0xcd, 0x63, 0x89, 0x8e, 0x7d, 0x81, 0xfd, 0x58,
+		0x73, 0xa6, 0x29, 0xe1, 0xab, 0x79, 0x79, 0x5a, 0x00, 0xeb, 0xdf, 0x03, 0xd4, 0x03, 0x94, 0x69,
+		0x10, 0x2b, 0xe2, 0xe2, 0x0b, 0xa8, 0x11, 0x62, 0x08, 0x5c, 0xc1, 0xe4, 0x4d, 0x21, 0xdc, 0x71,
+		0x3d, 0x98, 0x4a, 0x8c, 0x70, 0xed, 0xd2, 0xb2, 0xc2, 0xfa, 0xba, 0x70, 0xd6, 0x26, 0xc3, 0x59,
+		0x95, 0xd6, 0xcd, 0x62, 0x69, 0x56, 0xb1, 0x14, 0xa3, 0x01, 0xf6, 0x86, 0x89, 0xf0, 0x8e, 0x87,
+		0x7a, 0xb0, 0xb6, 0x56, 0xd3, 0x1a, 0x4d, 0x44, 0x69, 0x30, 0x7d, 0x5b, 0xcc, 0x2e, 0x98, 0x94,
+		0x04, 0x2e, 0xe0, 0x12, 0xfb, 0xec, 0x86, 0x6b, 0x05, 0x23, 0x94, 0xa0, 0x30, 0x88, 0x45, 0xb8,
+		0x23, 0xc8, 0xf4, 0xf1, 0x7e, 0x3f, 0xd1, 0x99, 0x56, 0xfc, 0xf1, 0x11, 0x5a, 0x8c, 0xaa, 0x3f,
+		0xbc, 0x19, 0x29, 0x02, 0x50, 0x5f, 0x96, 0x88, 0x7c, 0x14, 0x3c, 0xb5, 0xde, 0xde, 0x65, 0xc5,
+		0xbb, 0x7e, 0x67, 0xa2, 0x8f, 0x95, 0x7e, 0x17, 0xc1, 0xc6, 0x5d, 0x72, 0x41, 0x5f, 0x36, 0xfd,
+		0xc1, 0xa2, 0x04, 0x97, 0xb7, 0x72, 0xd6, 0x3d, 0xde, 0x1b, 0xc9, 0x02, 0x33, 0x0f, 0x2e, 0x78,
+		0x9f, 0xdb, 0xf8, 0x33, 0xde, 0x3b, 0xec, 0x33, 0xcd, 0x6f, 0x91, 0xec, 0x3e, 0x10, 0x9c, 0x32,
+		0xe3, 0x20, 0xd5, 0x68, 0x6a, 0xa7, 0xd3, 0xe9, 0xec, 0x5e, 0x73, 0x6b, 0xba, 0x17, 0xd7, 0x0d,
+		0x74, 0x64, 0x10, 0xc7, 0x5f, 0x38, 0xc1, 0x98, 0xe7, 0x72, 0x34, 0xed, 0xf8, 0xdb, 0x88, 0xfd,
+		0x95, 0x20, 0xdc, 0x9a, 0xde, 0x9e, 0xae, 0x9f, 0x75, 0xbc, 0xb0, 0x35, 0x75, 0x33, 0x06, 0x66,
+		0x36, 0x19, 0xb5, 0x8c, 0xa3, 0xc8, 0xd9, 0xf0, 0x3d, 0xb3, 0xe1, 0x5c, 0x30, 0x39, 0x26, 0x68,
+		0xc6, 0xd7, 0x0d, 0xd0, 0x19, 0xce, 0xe1, 0xaa, 0x02, 0xa2, 0xb3, 0xc2, 0x34, 0x9c, 0xbe, 0x91,
+		0x88, 0x7e, 0x2f, 0x96, 0x43, 0x98, 0x29, 0x0b, 0x71, 0x6f, 0x1e, 0xa7, 0x0e, 0x97, 0xfb, 0x84,
+		0x4b, 0xa5, 0x25, 0x17, 0x7d, 0x8a, 0x6b, 0xf9, 0xaa, 0x09, 0x30, 0x93, 0x51, 0x84, 0xf7, 0x04,
+		0x4c, 0x66, 0x72, 0x34, 0x38, 0x5e, 0xa4, 0xc2, 0x60, 0xd6, 0x69, 0x07, 0x70, 0x81, 0x23, 0x89,
+		0x81, 0x59, 0xee, 0xb4, 0x01, 0x6f, 0x51, 0x8e, 0x41, 0x25, 0xa3, 0x51, 0x2c, 0xcd, 0xfa, 0x67,
+		0x88, 0x21, 0x4f, 0x86, 0x20, 0x13, 0xa1, 0xa0, 0x97, 0x44, 0x11, 0x94, 0xff, 0x8c, 0x03, 0xea,
+		0x2e, 0x02, 0x15, 0x45, 0x32, 0xc4, 0x95, 0xdc, 0x9a, 0x95, 0x68, 0x2d, 0xd9, 0xf0, 0xf0, 0x7e,
+		0x14, 0xc9, 0xb0, 0xba, 0x4f, 0xaf, 0xe2, 0x0f, 0xd9, 0xdc, 0x38, 0xa3, 0xb8, 0x90, 0x1d, 0x53,
+		0xc7, 0x01, 0x8b, 0x7a, 0x94, 0x9d, 0x8e, 0xae, 0x11, 0x36, 0x48, 0xf4, 0x1a, 0x85, 0x72, 0xae,
+		0xe2, 0xb7, 0x42, 0xd3, 0xaa, 0x97, 0xfe, 0x18, 0xc9, 0x67, 0xcd, 0x1a, 0x71, 0x06, 0x9d, 0x47,
+		0x09, 0xde, 0xe0, 0xbd, 0x96, 0xcc, 0x4f, 0x84, 0xd2, 0xec, 0x26, 0xaa, 0x40, 0x82, 0x09, 0x2a,
+		0x25, 0x6a, 0x13, 0xfe, 0xfe, 0xd4, 0x04, 0x16, 0x4a, 0x63, 0xcb, 0xfb, 0x53, 0x79, 0xd5, 0x1f,
+		0x73, 0x7f, 0x6a, 0xa6, 0x6d, 0x3b, 0xe9, 0x49, 0xa3, 0x30, 0x23, 0x1e, 0x56, 0xdb, 0x84, 0x42,
+		0xd0, 0x3e, 0x20, 0x36, 0x75, 0x9d, 0xb9, 0x02, 0x16, 0x0e, 0xb9, 0xe0, 0x4a, 0xcb, 0x74, 0x91,
+		0x11, 0x8d, 0xa1, 0xf2, 0xbd, 0x3d, 0x96, 0x44, 0xba, 0x14, 0x6e, 0x9e, 0x19, 0x9b, 0xd5, 0xdd,
+		0x7b, 0xed, 0x8c, 0x8b, 0x8b, 0xb0, 0x2d, 0x3c, 0x53, 0x6e, 0xa9, 0xaf, 0xcb, 0xaa, 0xb6, 0xcc,
+		0x45, 0xcd, 0xe4, 0x69, 0x33, 0xe0, 0x12, 0x43, 0xce, 0xc0, 0x14, 0x58, 0x72, 0xcd, 0xdb, 0x70,
+		0x37, 0xe0, 0xc1, 0x00, 0x6e, 0xe2, 0x44, 0x84, 0x19, 0xcd, 0xf1, 0xf2, 0xea, 0xa3, 0xf3, 0x83,
+		0xf6, 0x09, 0xaa, 0x3c, 0x44, 0xa1, 0xb9, 0x1e, 0x4b, 0xec, 0x51, 0xe0, 0x5a, 0xb2, 0xdf, 0xe5,
+		0xbd, 0xcd, 0x5f, 0xf5, 0x3d, 0x53, 0x48, 0xe7, 0x9d, 0x90, 0x40, 0x39, 0x1f, 0x42, 0x52, 0xa4,
+		0xcd, 0x51, 0x4b, 0xda, 0x42, 0xaa, 0xe7, 0x69, 0x2c, 0x8c, 0xf6, 0xa6, 0x7f, 0xfb, 0x8e, 0xf7,
+		0x78, 0x53, 0xb3, 0x7a, 0x6d, 0x0b, 0x1d, 0x9a, 0x8a, 0x51, 0xfe, 0x90, 0x09, 0xd6, 0xc7, 0x1c,
+		0xa4, 0x55, 0x1a, 0x66, 0x4e, 0x9c, 0xa6, 0x60, 0xde, 0x4b, 0x54, 0x28, 0x34, 0xdc, 0x0d, 0x50,
+		0x2c, 0xdb, 0x59, 0xf3, 0xa5, 0xbc, 0xc5, 0x10, 0x7a, 0xb1, 0x84, 0xe9, 0xbb, 0x41, 0x4b, 0xd6,
+		0xeb, 0xad, 0xe5, 0x2b, 0x39, 0x65, 0xb3, 0x93, 0xca, 0x06, 0x87, 0x23, 0x4d, 0x09, 0x5a, 0x75,
+		0x5f, 0x34, 0x80, 0x6c, 0x14, 0xb3, 0xd0, 0xef, 0xb1, 0x40, 0xc7, 0xb2, 0x1a, 0xb0, 0xb3, 0xc2,
+		0x34, 0xb8, 0x7e, 0x18, 0x30, 0xb9, 0x6c, 0x0a, 0xe7, 0x37, 0xa2, 0x12, 0xe5, 0xc2, 0x56, 0x7b,
+		0x05, 0xcc, 0x10, 0x03, 0x3e, 0x64, 0xd1, 0xe9, 0x31, 0x05, 0x9c, 0x47, 0xed, 0x16, 0x7d, 0xbb,
+		0xe2, 0x68, 0x67, 0x37, 0x9c, 0x6a, 0xef, 0xc0, 0x1c, 0xed, 0xe3, 0x86, 0xd3, 0xee, 0x35, 0xf6,
+		0x09, 0x16, 0xc9, 0x43, 0x16, 0x54, 0xf2, 0x3a, 0x27, 0x73, 0x62, 0x56, 0x98, 0x78, 0xfc, 0x89,
+		0xc9, 0xf0, 0xce, 0x68, 0xc7, 0xbc, 0x98, 0x8b, 0xe5, 0xef, 0xb5, 0x52, 0xac, 0x06, 0x00, 0x10,
+		0x49, 0x8e, 0x64, 0x52, 0xa3, 0x67, 0x68, 0x86, 0xcc, 0xef, 0x9d, 0xfb, 0x6f, 0xae, 0xbf, 0x1e,
+		0x3d, 0x3c, 0x3b, 0x9b, 0xff, 0xf7, 0xf3, 0xaf, 0x27, 0x0f, 0xde, 0x76, 0x66, 0x86, 0x4e, 0x08,
+		0x33, 0x42, 0x27, 0xd4, 0x45, 0x33, 0xbb, 0xe7, 0xc3, 0x64, 0x08, 0x57, 0x92, 0x09, 0x35, 0xe4,
+		0x4a, 0xf1, 0x58, 0x80, 0x21, 0x22, 0x00, 0x17, 0x70, 0x33, 0xd6, 0x95, 0x27, 0x00, 0xdd, 0x54,
+		0xd8, 0xad, 0xa9, 0xa0, 0x13, 0x5f, 0xf1, 0xff, 0x22, 0x61, 0x1e, 0x9c, 0x52, 0xa8, 0x28, 0x65,
+		0x10, 0x80, 0xa7, 0x77, 0x0d, 0x4e, 0x5f, 0x7d, 0x3b, 0x64, 0x94, 0xd3, 0x93, 0x93, 0x17, 0x27,
+		0x8e, 0x8c, 0x02, 0xe0, 0x89, 0x0c, 0xec, 0x15, 0x4a, 0x30, 0x95, 0xb2, 0xa5, 0xe9, 0x99, 0x42,
+		0xf0, 0x0c, 0x0f, 0xfa, 0x07, 0x6d, 0x40, 0x3d, 0xe8, 0xb4, 0xe1, 0x2e, 0x62, 0xa2, 0xf3, 0xdc,
+		0x69, 0xc1, 0xa7, 0xd7, 0x82, 0x97, 0x4c, 0x84, 0x4c, 0xc7, 0x72, 0xbc, 0x7e, 0x43, 0x6f, 0x3b,
+		0x04, 0x80, 0x4d, 0xf8, 0x0b, 0xa8, 0x07, 0xe9, 0xc9, 0x84, 0xff, 0xff, 0xdb, 0x00, 0x2a, 0xfb,
+		0x73, 0x3b, 0x1e, 0xc2, 0x88, 0x29, 0x95, 0x0f, 0x70, 0xc5, 0x0c, 0x99, 0x48, 0x12, 0x03, 0x0a,
+		0x18, 0x48, 0xd4, 0x90, 0xa8, 0x8c, 0xa4, 0xc5, 0x12, 0x3d, 0x30, 0x71, 0x54, 0xb3, 0xd7, 0xe6,
+		0x58, 0xd6, 0xbb, 0x36, 0x59, 0x76, 0x8d, 0x09, 0x33, 0x92, 0x3c, 0x96, 0x5c, 0x8f, 0x09, 0xa8,
+		0x2c, 0x24, 0x6d, 0x75, 0x77, 0x51, 0x10, 0x22, 0xbc, 0xc5, 0xc8, 0x81, 0x70, 0x9f, 0x40, 0x58,
+		0x8c, 0x9d, 0x5f, 0x36, 0x76, 0x50, 0x9d, 0x79, 0x04, 0x1c, 0x3f, 0x9a, 0x3c, 0x3c, 0xcd, 0x5d,
+		0xd2, 0xbd, 0x73, 0x47, 0xdb, 0x4f, 0x83, 0x88, 0xce, 0x37, 0x44, 0x99, 0x77, 0x4b, 0x14, 0x00,
+		0xf0, 0xd2, 0xe3, 0x68, 0xd5, 0xb6, 0x2e, 0x13, 0x23, 0xb2, 0x90, 0xf9, 0x3d, 0x86, 0x33, 0x87,
+		0xdd, 0xda, 0x10, 0x8b, 0x68, 0x6c, 0xd8, 0xf3, 0x3c, 0x34, 0xfc, 0x86, 0x08, 0x61, 0xfe, 0x28,
+		0x1d, 0x70, 0x05, 0x22, 0xd6, 0xa9, 0xfe, 0x77, 0xd6, 0xd0, 0x71, 0x3e, 0x37, 0xcb, 0xf9, 0xec,
+		0x76, 0x2e, 0xc9, 0x94, 0xcf, 0x6e, 0x87, 0x26, 0x7c, 0x94, 0x0a, 0xff, 0x44, 0x11, 0x7d, 0x91,
+		0xbd, 0xf7, 0xa7, 0x47, 0x63, 0x92, 0xa6, 0x6d, 0xa0, 0x31, 0x49, 0x4d, 0xb5, 0xce, 0xe0, 0x05,
+		0x49, 0xf2, 0x92, 0xa6, 0xf9, 0x4c, 0xaf, 0x9c, 0xc1, 0xd1, 0x66, 0xb9, 0xa9, 0x34, 0x4d, 0xa6,
+		0x49, 0xa7, 0x78, 0x33, 0x31, 0xea, 0xb9, 0x9f, 0x1c, 0xdc, 0x2c, 0x82, 0xb4, 0x9c, 0xed, 0xfe,
+		0x4b, 0xb7, 0x4a, 0x5d, 0x1d, 0x39, 0x75, 0xd5, 0x58, 0x5d, 0x55, 0xa6, 0x9d, 0x0b, 0xe2, 0xc4,
+		0x8c, 0x97, 0xa2, 0x53, 0xa8, 0x26, 0x25, 0xaa, 0x12, 0xc0, 0xac, 0x5b, 0xe2, 0xe5, 0x64, 0x1a,
+		0xa0, 0xbe, 0xa8, 0x1c, 0x2f, 0xcb, 0xb8, 0x71, 0x59, 0x80, 0x36, 0x81, 0xb3, 0x6a, 0x85, 0x04,
+		0x1b, 0xcd, 0x02, 0x24, 0xfc, 0x38, 0xd0, 0xa8, 0xeb, 0xa4, 0x00, 0x9a, 0x14, 0xad, 0x97, 0xff,
+		0xe7, 0xb7, 0xb4, 0x30, 0x48, 0x0c, 0x90, 0x1b, 0xee, 0x57, 0x2c, 0x48, 0x7a, 0x6c, 0x1d, 0x4e,
+		0x5d, 0xf6, 0x1f, 0x97, 0xfd, 0xa7, 0x41, 0xf6, 0x9f, 0x84, 0x0b, 0x5d, 0xca, 0x0c, 0x5a, 0x87,
+		0x3b, 0x9b, 0xb4, 0x3f, 0xb4, 0xe0, 0xca, 0xe2, 0x63, 0x07, 0x06, 0xb0, 0x5d, 0x6a, 0xaf, 0x5d,
+		0x8e, 0x76, 0xda, 0xf5, 0xca, 0xd7, 0x5d, 0x9d, 0x36, 0x5f, 0xad, 0xd6, 0x84, 0x4d, 0xed, 0x95,
+		0xfb, 0xda, 0xae, 0x6b, 0x96, 0x44, 0x68, 0x57, 0x7b, 0xb3, 0xb5, 0x1d, 0xe9, 0xeb, 0x4d, 0xe5,
+		0x38, 0xaa, 0xee, 0x18, 0x2f, 0x4e, 0x74, 0x6d, 0x83, 0x37, 0x53, 0xb6, 0x91, 0xc5, 0x4b, 0x89,
+		0xd0, 0xce, 0xda, 0x39, 0x6b, 0x07, 0xe0, 0xac, 0xdd, 0xd2, 0xe3, 0xac, 0x9d, 0xb3, 0x76, 0xce,
+		0xda, 0xed, 0x6b, 0x46, 0xbf, 0x34, 0x24, 0x75, 0x48, 0x0c, 0x31, 0x64, 0x6f, 0xd6, 0x32, 0x09,
+		0x74, 0x4e, 0x4b, 0x2a, 0x6e, 0xc8, 0xb8, 0x48, 0xdf, 0xfb, 0x79, 0x12, 0xbc, 0xf8, 0x6c, 0x92,
+		0xc5, 0xe2, 0xe7, 0x1f, 0x8a, 0xf7, 0x6e, 0x21, 0x95, 0xf2, 0x7f, 0x92, 0xe1, 0x4d, 0xec, 0x97,
+		0x51, 0x44, 0x97, 0xb4, 0xe8, 0xb4, 0x88, 0x5d, 0x4c, 0xe6, 0x57, 0x26, 0xfb, 0xa8, 0xb4, 0x39,
+		0x47, 0xb9, 0x14, 0xbd, 0xcb, 0x12, 0xef, 0xa5, 0x6f, 0x86, 0x9e, 0x64, 0x43, 0x54, 0xe6, 0x00,
+		0x66, 0xac, 0x10, 0xa4, 0x51, 0xa7, 0xc0, 0x55, 0x2a, 0x7f, 0x79, 0xf5, 0xd1, 0xfc, 0xc1, 0x34,
+		0x08, 0xc4, 0x30, 0xfd, 0xa8, 0x87, 0x4c, 0x27, 0x12, 0x89, 0x61, 0x9d, 0x8e, 0x0b, 0xeb, 0x34,
+		0x77, 0x08, 0x1e, 0x3b, 0xac, 0x43, 0x36, 0xf8, 0x36, 0x9c, 0x56, 0x0b, 0x6e, 0xab, 0x3d, 0xc7,
+		0xb5, 0xa6, 0x33, 0x60, 0x97, 0xf7, 0xd5, 0xde, 0x85, 0x9c, 0xee, 0xb8, 0x76, 0xba, 0xed, 0xd6,
+		0xa3, 0x5a, 0xa8, 0xfa, 0x96, 0xe9, 0xc1, 0x2e, 0xa7, 0x6d, 0xfd, 0x5e, 0xb1, 0x60, 0xcb, 0xee,
+		0x42, 0xb7, 0x6c, 0xc8, 0xa0, 0x5e, 0x37, 0x9a, 0x9a, 0x16, 0x49, 0x49, 0x8a, 0xc7, 0xe3, 0x3d,
+		0xbf, 0x50, 0xda, 0x5b, 0x98, 0x18, 0xf3, 0x56, 0x2a, 0xb3, 0x25, 0x8f, 0xbc, 0x3c, 0x9b, 0x69,
+		0xe0, 0x53, 0x2e, 0xd1, 0xe6, 0x7a, 0x60, 0xd7, 0x70, 0xb5, 0xa3, 0x19, 0x8d, 0xcb, 0xf6, 0x24,
+		0xc1, 0xd2, 0x6f, 0xf3, 0x1a, 0x6e, 0xa1, 0x26, 0x8a, 0xb6, 0x87, 0x9a, 0x28, 0x6b, 0xde, 0x63,
+		0xbc, 0xb0, 0x9d, 0x9a, 0x28, 0x97, 0x4c, 0x76, 0x1f, 0x93, 0xc9, 0x26, 0x82, 0xc8, 0xf7, 0x78,
+		0x5d, 0x22, 0x93, 0xff, 0xdc, 0xc6, 0x52, 0x45, 0xd1, 0xa8, 0x28, 0x36, 0x94, 0x14, 0x3b, 0x6a,
+		0x4a, 0x3d, 0x8a, 0xca, 0x3c, 0x55, 0x25, 0x19, 0xd9, 0xd8, 0x8c, 0x94, 0xb1, 0x12, 0xc6, 0x77,
+		0x56, 0x57, 0x02, 0xa5, 0xcc, 0x15, 0x8d, 0x4a, 0x97, 0x53, 0xa9, 0x57, 0xd3, 0x58, 0xc2, 0x58,
+		0x0e, 0x99, 0x20, 0x86, 0xe0, 0xc8, 0x81, 0x35, 0x32, 0xb5, 0xa5, 0x78, 0x26, 0xf5, 0x20, 0x71,
+		0x57, 0x66, 0x4a, 0xdd, 0x09, 0x3a, 0xed, 0x15, 0x00, 0xa6, 0x1d, 0x45, 0x3e, 0xb5, 0x0c, 0x00,
+		0xe9, 0x30, 0x56, 0xa5, 0x67, 0xab, 0xb6, 0x48, 0x34, 0x89, 0x87, 0xf6, 0xa6, 0xe6, 0x4f, 0x25,
+		0xbb, 0x7e, 0x69, 0xea, 0x10, 0xc2, 0x93, 0xd6, 0x77, 0x6f, 0x78, 0x43, 0x66, 0xcc, 0xa2, 0x60,
+		0x22, 0x40, 0xff, 0xe0, 0xff, 0xbc, 0xad, 0x19, 0xfc, 0x26, 0x9c, 0x49, 0xcd, 0xfa, 0x04, 0x23,
+		0x99, 0x4a, 0xd9, 0xe6, 0x6d, 0x8d, 0xd8, 0x0d, 0x46, 0x0a, 0x98, 0xd6, 0x2c, 0x18, 0xac, 0xc8,
+		0x31, 0xdc, 0x94, 0x6f, 0xe4, 0xe8, 0x91, 0x74, 0x98, 0xd4, 0xe6, 0x1b, 0x69, 0xd6, 0xa7, 0xc7,
+		0xb5, 0x8c, 0xb0, 0x5d, 0x44, 0xeb, 0x3c, 0x43, 0x49, 0x1b, 0x98, 0x02, 0x06, 0x5f, 0x70, 0x0c,
+		0x4c, 0x84, 0x79, 0x72, 0xea, 0x11, 0xe3, 0xd2, 0x71, 0x8d, 0x1c, 0xd7, 0xc8, 0xfb, 0x82, 0x63,
+		0xfb, 0x4d, 0x57, 0x53, 0xa8, 0xde, 0x6e, 0xab, 0x79, 0x43, 0x11, 0x5e, 0x4d, 0xd1, 0xe9, 0xb6,
+		0x58, 0xa9, 0x8f, 0xdb, 0x62, 0x05, 0x00, 0x68, 0xb6, 0xc5, 0x4a, 0x76, 0x5e, 0x88, 0x47, 0x05,
+		0xed, 0xdb, 0x49, 0x68, 0xa3, 0x77, 0x9b, 0x87, 0xfc, 0x2c, 0x67, 0x65, 0x56, 0xac, 0xde, 0xbc,
+		0x4c, 0xa3, 0x8c, 0x6e, 0x62, 0xba, 0x89, 0xf9, 0xed, 0x4e, 0xcc, 0x46, 0xf6, 0xf6, 0x17, 0x1c,
+		0xd3, 0x0c, 0xa3, 0xdd, 0x45, 0x7a, 0xf6, 0x17, 0xe8, 0x6d, 0xe4, 0xe2, 0xbc, 0x1a, 0x17, 0xe6,
+		0xd5, 0xb8, 0x28, 0xef, 0xa9, 0xb6, 0xc3, 0xcd, 0x82, 0xeb, 0xb0, 0xda, 0x9d, 0x06, 0x7a, 0x40,
+		0xf5, 0x8a, 0xf5, 0x95, 0xf9, 0xcf, 0x7e, 0xde, 0x93, 0x57, 0xb2, 0x00, 0xb5, 0xec, 0x83, 0x26,
+		0x31, 0x65, 0x9d, 0x08, 0x81, 0x11, 0x61, 0xb9, 0x9c, 0xc9, 0xd1, 0x16, 0xcc, 0x57, 0xa9, 0x30,
+		0x28, 0xd4, 0x26, 0x3c, 0xa3, 0x8a, 0xb4, 0xc9, 0x43, 0x36, 0x86, 0x1b, 0x84, 0x08, 0x7b, 0x1a,
+		0xd2, 0xcc, 0x98, 0xa0, 0x63, 0x48, 0x54, 0x96, 0xf5, 0x21, 0xcc, 0xf2, 0x85, 0x2b, 0xb7, 0x84,
+		0xde, 0x83, 0x25, 0xb4, 0xc4, 0x61, 0xac, 0x91, 0x7c, 0x65, 0xf2, 0xa4, 0x63, 0x17, 0xca, 0x59,
+		0x2e, 0xac, 0xe7, 0x93, 0xec, 0xf5, 0x98, 0x04, 0x14, 0x61, 0xf1, 0xcf, 0x52, 0x80, 0x2e, 0xa2,
+		0xc8, 0x31, 0x3e, 0x1a, 0xa0, 0xce, 0x1e, 0x7d, 0xd5, 0xaa, 0x18, 0xb6, 0xc2, 0xf8, 0xb0, 0xbc,
+		0xc6, 0x78, 0xbb, 0xa1, 0xd3, 0xdd, 0xba, 0xae, 0xf8, 0x7a, 0xe7, 0xae, 0x27, 0x19, 0xa5, 0x69,
+		0xb1, 0x83, 0x8d, 0xee, 0x3a, 0x5d, 0xcd, 0x06, 0x67, 0x01, 0x45, 0xc0, 0x46, 0x2a, 0x89, 0x98,
+		0xc6, 0x2c, 0x79, 0x7f, 0x71, 0x0c, 0x90, 0x0b, 0x60, 0x34, 0x05, 0xb2, 0x81, 0x99, 0x99, 0x35,
+		0xf2, 0x31, 0xe7, 0x66, 0x8d, 0x5e, 0x78, 0xec, 0x64, 0x08, 0x8f, 0xe5, 0x73, 0x55, 0x8d, 0x31,
+		0xd5, 0xeb, 0x2a, 0xe9, 0x25, 0x9a, 0xdf, 0x75, 0x1b, 0x31, 0xe1, 0x73, 0x42, 0x6a, 0x87, 0x42,
+		0x90, 0xe6, 0x79, 0x9d, 0x07, 0x81, 0x31, 0x91, 0x7f, 0xfc, 0x7a, 0xfe, 0x6e, 0xc5, 0xed, 0x15,
+		0x4b, 0x89, 0x1e, 0xb8, 0x06, 0xae, 0xaa, 0x6e, 0x72, 0x71, 0xb9, 0x1d, 0x9a, 0xd8, 0xbe, 0xad,
+		0xed, 0xf2, 0x73, 0xa1, 0xbb, 0xa7, 0x0d, 0xf3, 0x73, 0xba, 0x0c, 0x47, 0xa4, 0x61, 0xa9, 0x4d,
+		0x27, 0x9c, 0x34, 0xf5, 0xb8, 0xf3, 0xfa, 0xf8, 0x9f, 0x9e, 0xd0, 0x66, 0x03, 0x3e, 0x80, 0xb9,
+		0x12, 0x63, 0x93, 0xf6, 0xff, 0xe0, 0xe0, 0x30, 0x57, 0x6e, 0xf0, 0x2f, 0xf8, 0xce, 0x4c, 0xeb,
+		0xef, 0xb6, 0x6c, 0xe0, 0xd3, 0x16, 0x3c, 0xa6, 0x71, 0x5f, 0xd5, 0xc4, 0xa7, 0x4f, 0x65, 0xd4,
+		0x2a, 0x41, 0x86, 0x77, 0x9e, 0xf4, 0x4d, 0xed, 0x31, 0x5c, 0x39, 0xd4, 0x15, 0xf6, 0xd0, 0x18,
+		0xf7, 0xb3, 0x5d, 0xdb, 0x70, 0x77, 0x0c, 0x35, 0x4a, 0xd4, 0xa0, 0xfa, 0x82, 0xfc, 0xa5, 0xbe,
+		0xad, 0xba, 0x28, 0x7f, 0x9d, 0x1f, 0xd4, 0xf8, 0xc2, 0xfc, 0x45, 0x24, 0x3d, 0x75, 0xc4, 0xa0,
+		0x02, 0x61, 0x9b, 0x50, 0x5d, 0x4f, 0x13, 0x35, 0x28, 0x47, 0x20, 0x51, 0x61, 0x6d, 0x3c, 0x72,
+		0x40, 0xbe, 0x90, 0x7f, 0x11, 0x27, 0x2f, 0x6d, 0x4e, 0x8c, 0x5c, 0x12, 0xdf, 0xbd, 0xa3, 0x07,
+		0x46, 0xdc, 0x69, 0x91, 0x06, 0x17, 0xfd, 0xef, 0x42, 0xb7, 0x3c, 0x35, 0xab, 0xbf, 0x4d, 0xb8,
+		0xac, 0x96, 0x68, 0x28, 0x48, 0x37, 0xc4, 0x6e, 0x80, 0xfb, 0xbe, 0x38, 0xdf, 0x9d, 0x5d, 0xf8,
+		0xa6, 0xec, 0x42, 0x15, 0xb7, 0xde, 0x86, 0x63, 0xbf, 0x58, 0x8d, 0xad, 0x9d, 0x7a, 0xb2, 0xe3,
+		0xde, 0x2f, 0x35, 0xe1, 0xd8, 0xa2, 0x8c, 0x15, 0x17, 0xbf, 0x19, 0x27, 0xbf, 0x01, 0x37, 0xbf,
+		0x11, 0x47, 0xbf, 0x29, 0x57, 0xbf, 0x01, 0x67, 0x9f, 0x88, 0xeb, 0x0d, 0x70, 0xf8, 0x8b, 0xa7,
+		0x1e, 0x97, 0xbf, 0x01, 0xa7, 0xbf, 0x78, 0xea, 0x71, 0xfb, 0x8b, 0xc7, 0x86, 0xe3, 0x4f, 0x53,
+		0x25, 0xf6, 0x92, 0xc4, 0x41, 0x7a, 0x5c, 0xd6, 0x8e, 0x45, 0x19, 0xdb, 0x0d, 0xae, 0xda, 0x67,
+		0x04, 0x68, 0x6e, 0x04, 0xbd, 0xf3, 0xaf, 0xb7, 0x9d, 0xdd, 0xb4, 0x55, 0x72, 0x51, 0x79, 0xd5,
+		0x96, 0x05, 0x75, 0xab, 0xc2, 0x6b, 0xb7, 0xea, 0xed, 0x4d, 0x78, 0xad, 0xd5, 0x75, 0x9d, 0x81,
+		0xa3, 0xa7, 0xc6, 0x4a, 0xe3, 0xb2, 0x9e, 0x9e, 0x02, 0x2c, 0xfb, 0xbe, 0xdd, 0x2a, 0x73, 0x9e,
+		0xb2, 0x1f, 0xf6, 0xef, 0x78, 0x88, 0x10, 0xa4, 0x5e, 0x4e, 0xb2, 0xd2, 0xc6, 0xac, 0x89, 0xc5,
+		0xac, 0xf5, 0x8c, 0xca, 0x3c, 0xa1, 0x8a, 0x7d, 0x82, 0x2a, 0x2f, 0x87, 0xec, 0xd5, 0x90, 0xbd,
+		0x98, 0xea, 0x7d, 0x80, 0xf2, 0x50, 0xd8, 0xba, 0x98, 0x89, 0x97, 0xd3, 0x61, 0x7c, 0xd2, 0x3d,
+		0x6d, 0xb3, 0xc2, 0xc4, 0xfb, 0xda, 0x56, 0xa4, 0xdb, 0xc8, 0x93, 0x68, 0x84, 0x31, 0x88, 0x58,
+		0x83, 0x42, 0x6d, 0xbe, 0xe6, 0x12, 0xd6, 0x9b, 0x47, 0xb7, 0x35, 0x54, 0x0b, 0x12, 0x15, 0x0a,
+		0xc7, 0x5d, 0xde, 0xe6, 0x2e, 0x6f, 0xdb, 0x40, 0x3a, 0x8a, 0x7f, 0xf8, 0xcd, 0x08, 0xa1, 0x50,
+		0x7e, 0x7a, 0x53, 0x3a, 0xe1, 0xd2, 0xeb, 0x19, 0x59, 0x9a, 0x7a, 0xbc, 0x78, 0xf7, 0x01, 0xb2,
+		0x02, 0xaa, 0x0d, 0x7f, 0x25, 0x28, 0x39, 0x86, 0xc0, 0xb3, 0x34, 0x85, 0xb1, 0x61, 0xdc, 0x9a,
+		0xbf, 0xc6, 0xc0, 0x24, 0x42, 0xc4, 0x95, 0x76, 0x7b, 0xe7, 0xdf, 0xd8, 0x55, 0x55, 0xed, 0x56,
+		0x7d, 0x92, 0x39, 0x9d, 0x5c, 0xde, 0x88, 0x54, 0x3e, 0x47, 0x26, 0x27, 0x06, 0xd1, 0x12, 0x85,
+		0x95, 0xc7, 0x13, 0x2d, 0x02, 0x49, 0xb3, 0x50, 0x8a, 0xb3, 0xda, 0xf8, 0x37, 0x94, 0x23, 0x6c,
+		0xb5, 0x82, 0x48, 0x73, 0xb0, 0x4a, 0x5b, 0xb2, 0x85, 0xb0, 0xe4, 0x22, 0xdd, 0xde, 0x54, 0xad,
+		0xc9, 0x0d, 0x94, 0x7a, 0x44, 0x56, 0x62, 0x33, 0xb2, 0x34, 0x25, 0xf6, 0xee, 0xea, 0xfd, 0x54,
+		0x89, 0x71, 0x01, 0x22, 0x86, 0x11, 0x93, 0x9a, 0x07, 0x49, 0xc4, 0x64, 0xa6, 0xc6, 0x9c, 0xda,
+		0x72, 0x6a, 0x6b, 0x87, 0xd5, 0x56, 0xf9, 0x19, 0x18, 0x8b, 0xb3, 0x2f, 0xd6, 0x0c, 0x85, 0x46,
+		0x8b, 0xf8, 0x95, 0xcb, 0x67, 0xa8, 0x5a, 0xc1, 0x7f, 0xc8, 0x4a, 0xad, 0x5b, 0xbe, 0xb7, 0x66,
+		0xea, 0xb9, 0xae, 0x7e, 0x1e, 0x57, 0x6f, 0xd8, 0x17, 0xfc, 0x3d, 0x8e, 0x97, 0x21, 0xbd, 0x58,
+		0x67, 0xaf, 0xdd, 0x5a, 0x53, 0xad, 0xac, 0x3e, 0x5e, 0xf6, 0x83, 0xad, 0x87, 0xff, 0x01, 0x00,
+		0x00, 0xff, 0xff, 0x03, 0x00, 0x1a, 0x03, 0xa1, 0x40, 0x42, 0xb6, 0x00, 0x00,
 	}
 )
 
@@ -375,6 +1589,15 @@ var (
 // of the map ensures that there are no clashes with valid YANG identifiers.
 func initΛEnumTypes() {
 	ΛEnumTypes = map[string][]reflect.Type{
+		"/interface/duplex": []reflect.Type{
+			reflect.TypeOf((E_NetworkDevice_Interface_Duplex)(0)),
+		},
+		"/interface/interface-type": []reflect.Type{
+			reflect.TypeOf((E_NetworkDevice_InterfaceType)(0)),
+		},
+		"/interface/speed": []reflect.Type{
+			reflect.TypeOf((E_NetworkDevice_Interface_Speed)(0)),
+		},
 		"/interface/status": []reflect.Type{
 			reflect.TypeOf((E_NetworkDevice_Interface_Status)(0)),
 		},
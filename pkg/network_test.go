@@ -0,0 +1,275 @@
+package network
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+// emitRFC7951 returns d as RFC7951 JSON, failing t on error.
+func emitRFC7951(t *testing.T, d *Device) string {
+	t.Helper()
+	out, err := ygot.EmitJSON(d, &ygot.EmitJSONConfig{Format: ygot.RFC7951})
+	if err != nil {
+		t.Fatalf("EmitJSON() error = %v", err)
+	}
+	return out
+}
+
+func TestBinaryCookie(t *testing.T) {
+	cookie := []byte{0x00, 0xff, 0x10, 'c'}
+	d := &Device{}
+	d.GetOrCreateInterface().Cookie = cookie
+
+	out := emitRFC7951(t, d)
+	if !strings.Contains(out, `"cookie": "AP8QYw=="`) {
+		t.Errorf("EmitJSON() = %s, want the base64 encoded cookie", out)
+	}
+	got := &Device{}
+	if err := Parse([]byte(out), got); err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if !bytes.Equal(got.GetInterface().Cookie, cookie) {
+		t.Errorf("cookie = %x, want %x", got.GetInterface().Cookie, cookie)
+	}
+
+	if err := Parse([]byte(`{"interface":{"cookie":"not base64!"}}`), &Device{}); err == nil {
+		t.Error("Parse() of invalid base64 error = nil, want an error")
+	}
+}
+
+func TestEnabled(t *testing.T) {
+	tests := []struct {
+		desc string
+		in   string
+		want bool
+	}{
+		{"explicit false", `{"interface": {"enabled": false}}`, false},
+		{"explicit true", `{"interface": {"enabled": true}}`, true},
+		{"default", `{"interface": {"name": "eth0"}}`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			d := &Device{}
+			if err := Parse([]byte(tt.in), d); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got := d.GetInterface().GetEnabled(); got != tt.want {
+				t.Errorf("GetEnabled() = %v, want %v", got, tt.want)
+			}
+			d.PopulateDefaults()
+			want := `"enabled": false`
+			if tt.want {
+				want = `"enabled": true`
+			}
+			if out := emitRFC7951(t, d); !strings.Contains(out, want) {
+				t.Errorf("EmitJSON() with defaults = %s, want %s", out, want)
+			}
+		})
+	}
+}
+
+func TestEnabledInvalidType(t *testing.T) {
+	if err := Parse([]byte(`{"interface": {"enabled": "yes"}}`), &Device{}); err == nil {
+		t.Error(`Parse() of "enabled": "yes" error = nil, want an error`)
+	}
+}
+
+func TestAddressModeChoice(t *testing.T) {
+	tests := []struct {
+		desc    string
+		dhcp    *bool
+		addrs   []string
+		wantErr bool
+	}{
+		{desc: "dhcp", dhcp: ygot.Bool(true)},
+		{desc: "static", addrs: []string{"10.0.0.1"}},
+		{desc: "both", dhcp: ygot.Bool(true), addrs: []string{"10.0.0.1"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			d := &Device{}
+			iface := d.GetOrCreateInterface()
+			iface.Dhcp = tt.dhcp
+			iface.Ipv4Address = tt.addrs
+			if err := ValidateDevice(d); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateDevice() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNamePatternAnchored(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		wantErr bool
+	}{
+		{"eth0", false},
+		{"wlan12", false},
+		{"xeth0", true},
+		{"eth0x", true},
+		{"eth0wlan0", true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &Device{}
+			d.GetOrCreateInterface().Name = ygot.String(tt.name)
+			if err := ValidateDevice(d); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateDevice() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadFactor(t *testing.T) {
+	for _, tt := range []struct {
+		in      string
+		want    float64
+		wantErr bool
+	}{
+		{in: `0.75`, want: 0.75},
+		{in: `"0.75"`, want: 0.75},
+		{in: `1.00`, want: 1},
+		{in: `1.5`, wantErr: true},
+		{in: `-0.01`, wantErr: true},
+	} {
+		t.Run(tt.in, func(t *testing.T) {
+			var d Device
+			if err := Parse([]byte(`{"interface": {"load-factor": `+tt.in+`}}`), &d); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			err := ValidateDevice(&d)
+			if tt.wantErr {
+				if !errors.Is(err, ErrValidation) {
+					t.Errorf("ValidateDevice() error = %v, want ErrValidation", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ValidateDevice() error = %v", err)
+			}
+			if got := d.GetInterface().GetLoadFactor(); got != tt.want {
+				t.Errorf("load-factor = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMacAddress(t *testing.T) {
+	for _, tt := range []struct {
+		in      string
+		wantErr bool
+	}{
+		{"00:11:22:33:44:55", false},
+		{"aa:BB:cc:DD:ee:FF", false},
+		{"zz:11:22:33:44:55", true},
+		{"00-11-22-33-44-55", true},
+		{"00:11:22:33:44", true},
+		{"00:11:22:33:44:55:66", true},
+	} {
+		t.Run(tt.in, func(t *testing.T) {
+			var d Device
+			err := ParseDevice([]byte(`{"interface": {"mac-address": "`+tt.in+`"}}`), &d)
+			if tt.wantErr {
+				if !errors.Is(err, ErrValidation) {
+					t.Errorf("ParseDevice() error = %v, want ErrValidation", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDevice() error = %v", err)
+			}
+			if got := d.GetInterface().GetMacAddress(); got != tt.in {
+				t.Errorf("mac-address = %q, want %q", got, tt.in)
+			}
+		})
+	}
+}
+
+func TestTunnelPresence(t *testing.T) {
+	for _, tt := range []struct {
+		desc string
+		in   string
+		want bool
+	}{
+		{"empty tunnel", `{"interface": {"name": "eth0", "tunnel": {}}}`, true},
+		{"tunnel with a remote address", `{"interface": {"name": "eth0", "tunnel": {"remote-address": "192.0.2.1"}}}`, true},
+		{"no tunnel", `{"interface": {"name": "eth0"}}`, false},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			var d Device
+			if err := ParseDevice([]byte(tt.in), &d); err != nil {
+				t.Fatalf("ParseDevice() error = %v", err)
+			}
+			if got := d.GetInterface().GetTunnel() != nil; got != tt.want {
+				t.Fatalf("tunnel present = %v, want %v", got, tt.want)
+			}
+
+			Prune(&d)
+			out := emitRFC7951(t, &d)
+			if got := strings.Contains(out, `"tunnel"`); got != tt.want {
+				t.Errorf("EmitJSON() = %s, want tunnel %v", out, tt.want)
+			}
+			var buf bytes.Buffer
+			if err := EmitJSONTo(&buf, &d, nil); err != nil {
+				t.Fatalf("EmitJSONTo() error = %v", err)
+			}
+			if buf.String() != out+"\n" {
+				t.Errorf("EmitJSONTo() = %s, want %s", buf.String(), out)
+			}
+
+			var back Device
+			if err := ParseDevice([]byte(out), &back); err != nil {
+				t.Fatalf("ParseDevice(%s) error = %v", out, err)
+			}
+			if got := back.GetInterface().GetTunnel() != nil; got != tt.want {
+				t.Errorf("tunnel present after a round trip = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmptyIsManagement(t *testing.T) {
+	for _, tt := range []struct {
+		desc string
+		in   string
+		want YANGEmpty
+	}{
+		{desc: "set", in: `{"interface": {"name": "eth0", "is-management": [null]}}`, want: true},
+		{desc: "unset", in: `{"interface": {"name": "eth0"}}`},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			var d Device
+			if err := ParseDevice([]byte(tt.in), &d); err != nil {
+				t.Fatalf("ParseDevice() error = %v", err)
+			}
+			if got := d.GetInterface().GetIsManagement(); got != tt.want {
+				t.Fatalf("is-management = %v, want %v", got, tt.want)
+			}
+
+			out := emitRFC7951(t, &d)
+			var tree struct {
+				Interface map[string]interface{} `json:"interface"`
+			}
+			if err := json.Unmarshal([]byte(out), &tree); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			got, ok := tree.Interface["is-management"]
+			if ok != bool(tt.want) || (ok && !reflect.DeepEqual(got, []interface{}{nil})) {
+				t.Errorf("EmitJSON() = %s, want is-management %v as [null]", out, tt.want)
+			}
+
+			var back Device
+			if err := ParseDevice([]byte(out), &back); err != nil {
+				t.Fatalf("ParseDevice(%s) error = %v", out, err)
+			}
+			if got := back.GetInterface().GetIsManagement(); got != tt.want {
+				t.Errorf("is-management after round trip = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package network
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+// Normalize puts d in canonical form, so that devices holding the same
+// configuration compare equal with ygot.Diff. Leaf-lists ordered by the system
+// are sorted, while "ordered-by user" leaf-lists keep their order, since it is
+// significant. A nil device is left as is.
+func Normalize(d *Device) {
+	if d == nil {
+		return
+	}
+	normalizeStruct(reflect.ValueOf(d).Elem())
+}
+
+// Canonicalize rewrites the RFC7951 JSON device data in canonical form, so
+// that files holding the same configuration are byte for byte identical and
+// diff cleanly. The data is unmarshaled with Parse and normalized, and the
+// result emitted like ygot.EmitJSON does, with object members sorted by name,
+// three-space indentation and a final newline. The device is not validated.
+func Canonicalize(data []byte) ([]byte, error) {
+	var d Device
+	if err := Parse(data, &d); err != nil {
+		return nil, err
+	}
+	Normalize(&d)
+	out, err := ygot.EmitJSON(&d, &ygot.EmitJSONConfig{Format: ygot.RFC7951, SkipValidation: true})
+	if err != nil {
+		return nil, fmt.Errorf("cannot emit canonical JSON: %w", err)
+	}
+	return []byte(out + "\n"), nil
+}
+
+// Prune removes the containers of d that hold no populated leaf, at any
+// depth, so that they are not emitted as empty JSON objects. Presence
+// containers, such as /interface/tunnel, are kept even when empty, since
+// their existence is meaningful on its own.
+func Prune(d *Device) {
+	if d == nil {
+		return
+	}
+	populated(reflect.ValueOf(d).Elem(), true)
+}
+
+// populated reports whether the GoStruct v holds a populated leaf, list
+// member or presence container, at any depth. With prune, it also removes the
+// containers below v that hold none of them.
+func populated(v reflect.Value, prune bool) bool {
+	var found bool
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		if _, ok := t.Field(i).Tag.Lookup("path"); !ok {
+			continue
+		}
+		f := v.Field(i)
+		switch {
+		case f.Kind() == reflect.Ptr && !f.IsNil() && f.Elem().Kind() == reflect.Struct:
+			switch {
+			case populated(f.Elem(), prune) || isPresence(t.Field(i)):
+				found = true
+			case prune:
+				f.Set(reflect.Zero(f.Type()))
+			}
+		case f.Kind() == reflect.Map:
+			// List members always hold their keys, so they are kept.
+			if prune {
+				for _, k := range f.MapKeys() {
+					populated(f.MapIndex(k).Elem(), prune)
+				}
+			}
+			found = found || f.Len() > 0
+		case f.Kind() == reflect.Slice:
+			found = found || f.Len() > 0
+		default:
+			found = found || !f.IsZero()
+		}
+		if found && !prune {
+			return true
+		}
+	}
+	return found
+}
+
+// isPresence reports whether the struct field f holds a presence container,
+// which the generator marks with a yangPresence tag.
+func isPresence(f reflect.StructField) bool {
+	return f.Tag.Get("yangPresence") == "true"
+}
+
+func normalizeStruct(v reflect.Value) {
+	schema := SchemaTree[v.Type().Name()]
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		tag, ok := t.Field(i).Tag.Lookup("path")
+		if !ok {
+			continue
+		}
+		f := v.Field(i)
+
+		switch f.Kind() {
+		case reflect.Ptr:
+			if !f.IsNil() && f.Elem().Kind() == reflect.Struct {
+				normalizeStruct(f.Elem())
+			}
+		case reflect.Map:
+			for _, k := range f.MapKeys() {
+				normalizeStruct(f.MapIndex(k).Elem())
+			}
+		case reflect.Slice:
+			e := childEntry(schema, tag)
+			if e == nil || !e.IsLeafList() || e.ListAttr.OrderedByUser {
+				continue
+			}
+			sort.SliceStable(f.Interface(), func(i, j int) bool {
+				return less(f.Index(i), f.Index(j))
+			})
+		}
+	}
+}
+
+// less orders two leaf-list values of the same kind.
+func less(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return a.Uint() < b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() < b.Float()
+	case reflect.String:
+		return a.String() < b.String()
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package network
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+func TestNormalize(t *testing.T) {
+	a := &Device{}
+	a.GetOrCreateSystem().NtpServer = []string{"10.0.0.2", "10.0.0.1"}
+	a.GetOrCreateSystem().DnsServer = []string{"10.0.1.2", "10.0.1.1"}
+	b := &Device{}
+	b.GetOrCreateSystem().NtpServer = []string{"10.0.0.1", "10.0.0.2"}
+	b.GetOrCreateSystem().DnsServer = []string{"10.0.1.2", "10.0.1.1"}
+
+	Normalize(a)
+	Normalize(b)
+	n, err := ygot.Diff(a, b)
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+	if len(n.GetUpdate()) != 0 || len(n.GetDelete()) != 0 {
+		t.Errorf("Diff() of normalized devices = %v, want no differences", n)
+	}
+	// dns-server is ordered by the user, so its order is kept.
+	if want := []string{"10.0.1.2", "10.0.1.1"}; !reflect.DeepEqual(a.System.DnsServer, want) {
+		t.Errorf("dns-server = %v, want %v", a.System.DnsServer, want)
+	}
+}
+
+func TestNormalizeIpv4Address(t *testing.T) {
+	a := &Device{}
+	a.GetOrCreateInterface().Ipv4Address = []string{"10.0.0.2", "10.0.0.1"}
+	b := &Device{}
+	b.GetOrCreateInterface().Ipv4Address = []string{"10.0.0.1", "10.0.0.2"}
+
+	Normalize(a)
+	Normalize(b)
+	n, err := ygot.Diff(a, b)
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+	if len(n.GetUpdate()) != 0 || len(n.GetDelete()) != 0 {
+		t.Errorf("Diff() of normalized devices = %v, want no differences", n)
+	}
+}
+
+func TestNormalizeNil(t *testing.T) {
+	Normalize(nil)
+}
+
+func TestPrune(t *testing.T) {
+	d := augmentDevice()
+	d.Interface.GetOrCreateState().GetOrCreateCounters()
+
+	Prune(d)
+	if d.Interface.State != nil {
+		t.Errorf("Prune() kept state %v, want it removed", d.Interface.State)
+	}
+	out := emitRFC7951(t, d)
+	if strings.Contains(out, `"state"`) {
+		t.Errorf("EmitJSON() after Prune() = %s, want no state", out)
+	}
+	if d.Interface.GetName() != "eth0" || d.Interface.GetMtu() != 1500 {
+		t.Errorf("Prune() changed the leaves: name %q, mtu %d", d.Interface.GetName(), d.Interface.GetMtu())
+	}
+}
+
+func TestPruneKeepsPopulatedState(t *testing.T) {
+	d := augmentDevice()
+	d.Interface.GetOrCreateState().GetOrCreateCounters().InOctets = ygot.Uint64(1)
+	Prune(d)
+	if d.Interface.GetState().GetCounters().GetInOctets() != 1 {
+		t.Errorf("Prune() removed a populated counter: %v", d.Interface.State)
+	}
+}
+
+func TestCanonicalize(t *testing.T) {
+	a := []byte(`{"interface": {"name": "eth0", "mtu": 1500, "network-device-extensions:status": "up"},
+"system": {"ntp-server": ["10.0.0.2", "10.0.0.1"], "dns-server": ["10.0.1.2", "10.0.1.1"]}}`)
+	b := []byte(`{
+  "system": {"dns-server": ["10.0.1.2", "10.0.1.1"], "ntp-server": ["10.0.0.1", "10.0.0.2"]},
+  "interface": {"status": "up", "mtu": 1500, "name": "eth0"}
+}`)
+
+	ca, err := Canonicalize(a)
+	if err != nil {
+		t.Fatalf("Canonicalize(a) error = %v", err)
+	}
+	cb, err := Canonicalize(b)
+	if err != nil {
+		t.Fatalf("Canonicalize(b) error = %v", err)
+	}
+	if string(ca) != string(cb) {
+		t.Errorf("Canonicalize() differs for reordered inputs:\n%s\n%s", ca, cb)
+	}
+	// The order of an "ordered-by user" leaf-list is kept.
+	if i, j := strings.Index(string(ca), "10.0.1.2"), strings.Index(string(ca), "10.0.1.1"); i > j {
+		t.Errorf("Canonicalize() = %s, want the dns-server order kept", ca)
+	}
+	if again, err := Canonicalize(ca); err != nil || string(again) != string(ca) {
+		t.Errorf("Canonicalize(canonical) = %s, %v, want it unchanged", again, err)
+	}
+
+	if _, err := Canonicalize([]byte(`{"interface": {"mtu": "big"}}`)); err == nil {
+		t.Error("Canonicalize(invalid) error = nil, want an error")
+	}
+}
@@ -0,0 +1,549 @@
+package network
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+)
+
+// Parse unmarshals data, which must be RFC7951 JSON format, into destStruct
+// in the same way as Unmarshal. When data is not valid JSON, the returned
+// error wraps the *json.SyntaxError and reports the line and column at which
+// it was found. A destStruct without schema yields ErrSchemaNotFound, and a
+// field missing from the schema ErrUnknownField. Invalid values of leaves
+// with an enumerated type are reported along with the valid names. Decimal64
+// values are accepted both as JSON numbers and, as RFC7951 requires, as
+// strings. With WithLogger, the parse is logged, and with ClampRanges,
+// numbers out of the range of their leaf are clamped.
+func Parse(data []byte, destStruct ygot.GoStruct, opts ...ytypes.UnmarshalOpt) error {
+	tn := reflect.TypeOf(destStruct).Elem().Name()
+	schema, ok := SchemaTree[tn]
+	if !ok {
+		return fmt.Errorf("%w for type %s", ErrSchemaNotFound, tn)
+	}
+
+	var tree interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&tree); err != nil {
+		return withPosition(data, err)
+	}
+	// Only one document is allowed, like json.Unmarshal does. This matters
+	// when tree is encoded again below, which would drop the rest.
+	if _, err := dec.Token(); err != io.EOF {
+		if err == nil {
+			line, col := position(data, dec.InputOffset())
+			return fmt.Errorf("invalid JSON at line %d col %d: unexpected data after the top-level value", line, col)
+		}
+		return withPosition(data, err)
+	}
+	if err := checkEnums(schema, tree, ""); err != nil {
+		return err
+	}
+	logger := parseLogger(opts)
+	changed := false
+	if hasOpt[clampRangesOpt](opts) {
+		changed = clampRanges(schema, tree, "", func(path string, from, to interface{}) {
+			for _, o := range opts {
+				if c, ok := o.(clampRangesOpt); ok && c.warnings != nil {
+					*c.warnings = append(*c.warnings, ClampWarning{Path: path, Value: from, Clamped: to})
+				}
+			}
+			if logger != nil {
+				logger.Warn("value out of range was clamped", "path", path, "value", from, "clamped", to)
+			}
+		})
+	}
+	if quoteDecimals(schema, tree) {
+		changed = true
+		if logger != nil {
+			logger.Debug("decimal64 values given as JSON numbers were read as strings", "type", tn)
+		}
+	}
+	if changed {
+		var err error
+		if data, err = json.Marshal(tree); err != nil {
+			return fmt.Errorf("cannot encode fixed-up values: %w", err)
+		}
+	}
+
+	err := Unmarshal(data, destStruct, opts...)
+	if err != nil && strings.Contains(err.Error(), "JSON contains unexpected field") {
+		return fmt.Errorf("%w: %w", ErrUnknownField, err)
+	}
+	if err == nil && logger != nil {
+		seen := map[string]bool{}
+		collectPresence(schema, tree, "", seen)
+		logger.Debug("parsed device", "type", tn, "leaves", len(seen))
+	}
+	return withPosition(data, err)
+}
+
+// withLogger is the option returned by WithLogger.
+type withLogger struct {
+	l *slog.Logger
+}
+
+// IsUnmarshalOpt marks withLogger as a ytypes.UnmarshalOpt.
+func (withLogger) IsUnmarshalOpt() {}
+
+// WithLogger returns an option that makes Parse, and the helpers built on it
+// such as ParseDevice, log to l: at debug level, a "parsed device" record
+// with the number of leaves read and a record for every fix-up applied to the
+// input, such as reading decimal64 numbers as strings, and as warnings, the
+// values clamped by ClampRanges. Nothing is logged without it. Unmarshal,
+// which is generated, ignores it.
+func WithLogger(l *slog.Logger) ytypes.UnmarshalOpt {
+	return withLogger{l}
+}
+
+// parseLogger returns the logger passed with WithLogger in opts, if any.
+func parseLogger(opts []ytypes.UnmarshalOpt) *slog.Logger {
+	for _, o := range opts {
+		if w, ok := o.(withLogger); ok && w.l != nil {
+			return w.l
+		}
+	}
+	return nil
+}
+
+// skipValidation is the option returned by SkipValidation.
+type skipValidation struct{}
+
+// IsUnmarshalOpt marks skipValidation as a ytypes.UnmarshalOpt.
+func (skipValidation) IsUnmarshalOpt() {}
+
+// SkipValidation returns an option that makes ParseDevice load data without
+// validating the result. It lets legacy data that is known to break some
+// restrictions, such as an out-of-range priority, be loaded and fixed before
+// it is validated with ValidateDevice. The data must still hold values of the
+// right types. Unmarshal and Parse, which never validate, ignore it.
+func SkipValidation() ytypes.UnmarshalOpt {
+	return skipValidation{}
+}
+
+// normalizeNames is the option returned by NormalizeNames.
+type normalizeNames struct{}
+
+// IsUnmarshalOpt marks normalizeNames as a ytypes.UnmarshalOpt.
+func (normalizeNames) IsUnmarshalOpt() {}
+
+// NormalizeNames returns an option that makes ParseDevice replace the
+// interface name with its short form, as returned by NormalizeName, before
+// validating the device. Unmarshal and Parse ignore it.
+func NormalizeNames() ytypes.UnmarshalOpt {
+	return normalizeNames{}
+}
+
+// clampRangesOpt is the option returned by ClampRanges and ClampRangesInto.
+type clampRangesOpt struct {
+	warnings *[]ClampWarning
+}
+
+// IsUnmarshalOpt marks clampRangesOpt as a ytypes.UnmarshalOpt.
+func (clampRangesOpt) IsUnmarshalOpt() {}
+
+// ClampRanges returns an option that makes Parse, and the helpers built on it
+// such as ParseDevice, accept integer and decimal64 values outside the range
+// of their leaf by replacing them with the nearest boundary of the range, e.g.
+// an mtu of 100000 with 65535 and a priority of 7 with 5. Each clamped value
+// is logged as a warning to the logger given with WithLogger. Use
+// ClampRangesInto to get the warnings without a logger. Unmarshal ignores it.
+func ClampRanges() ytypes.UnmarshalOpt {
+	return clampRangesOpt{}
+}
+
+// ClampWarning records a value replaced by ClampRanges: the path of the leaf
+// in the input, e.g. /interface/mtu, its value and the value it was clamped
+// to, both as decoded from the JSON input.
+type ClampWarning struct {
+	Path    string
+	Value   interface{}
+	Clamped interface{}
+}
+
+// ClampRangesInto returns an option that clamps values like ClampRanges, and
+// also appends a ClampWarning for each clamped value to w, whether or not a
+// logger is given with WithLogger.
+func ClampRangesInto(w *[]ClampWarning) ytypes.UnmarshalOpt {
+	return clampRangesOpt{warnings: w}
+}
+
+// hasOpt reports whether opts include an option of type T.
+func hasOpt[T ytypes.UnmarshalOpt](opts []ytypes.UnmarshalOpt) bool {
+	for _, o := range opts {
+		if _, ok := o.(T); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseDevice unmarshals data into d with Parse, and then validates d with
+// ValidateDevice unless opts include SkipValidation. With NormalizeNames, the
+// interface name is normalized first.
+func ParseDevice(data []byte, d *Device, opts ...ytypes.UnmarshalOpt) error {
+	if err := Parse(data, d, opts...); err != nil {
+		return err
+	}
+	validate := true
+	for _, o := range opts {
+		switch o.(type) {
+		case skipValidation:
+			validate = false
+		case normalizeNames:
+			if i := d.GetInterface(); i != nil && i.Name != nil {
+				name, err := NormalizeName(*i.Name)
+				if err != nil {
+					return fmt.Errorf("%w: %w", ErrValidation, err)
+				}
+				i.Name = &name
+			}
+		}
+	}
+	if !validate {
+		return nil
+	}
+	return ValidateDevice(d)
+}
+
+// checkEnums checks the JSON value v, found at path, against the schema entry
+// e, and reports the leaves whose type includes an enumeration but whose value
+// is not valid. ygot accepts such values when a string type of the same union
+// could hold them, and only rejects them with an obscure pattern error on
+// validation. Unknown nodes are left for Unmarshal to report.
+func checkEnums(e *yang.Entry, v interface{}, path string) error {
+	switch {
+	case e.IsList():
+		members, _ := v.([]interface{})
+		for i, m := range members {
+			if err := checkEnumsContainer(e, m, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case e.IsDir():
+		return checkEnumsContainer(e, v, path)
+	case e.IsLeafList():
+		values, _ := v.([]interface{})
+		for i, lv := range values {
+			if err := checkEnumLeaf(e.Type, lv, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	default:
+		return checkEnumLeaf(e.Type, v, path)
+	}
+	return nil
+}
+
+func checkEnumsContainer(e *yang.Entry, v interface{}, path string) error {
+	obj, _ := v.(map[string]interface{})
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		name := k
+		if i := strings.Index(k, ":"); i >= 0 {
+			name = k[i+1:]
+		}
+		if child := childEntry(e, name); child != nil {
+			if err := checkEnums(child, obj[k], path+"/"+name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func checkEnumLeaf(t *yang.YangType, v interface{}, path string) error {
+	names := enumNames(t)
+	if len(names) == 0 || validateJSONLeaf(t, v) == nil {
+		return nil
+	}
+	var alt strings.Builder
+	for _, p := range stringPatterns(t) {
+		fmt.Fprintf(&alt, " or a string matching %q", p)
+	}
+	return fmt.Errorf("%s: %v is not a valid value, want one of %v%s", path, v, names, alt.String())
+}
+
+// quoteDecimals replaces the JSON numbers given for the decimal64 leaves and
+// leaf-lists of v, an instance of the schema entry e, with strings, which is
+// how RFC7951 encodes them and what ygot expects. It reports whether it
+// changed anything. Unknown nodes are left for Unmarshal to report.
+func quoteDecimals(e *yang.Entry, v interface{}) bool {
+	if members, ok := v.([]interface{}); ok && e.IsList() {
+		changed := false
+		for _, m := range members {
+			changed = quoteDecimals(e, m) || changed
+		}
+		return changed
+	}
+	obj, _ := v.(map[string]interface{})
+	changed := false
+	for k, cv := range obj {
+		name := k
+		if i := strings.Index(k, ":"); i >= 0 {
+			name = k[i+1:]
+		}
+		child := childEntry(e, name)
+		switch {
+		case child == nil:
+		case child.IsDir():
+			changed = quoteDecimals(child, cv) || changed
+		case child.Type.Kind == yang.Ydecimal64 && quoteNumbers(&cv):
+			obj[k] = cv
+			changed = true
+		}
+	}
+	return changed
+}
+
+// clampRanges replaces the values of the integer and decimal64 leaves and
+// leaf-lists of v, an instance of the schema entry e at path, that are outside
+// the range of their type with the nearest boundary, and calls warn for each
+// of them. It reports whether it changed anything. Unknown nodes are left for
+// Unmarshal to report.
+func clampRanges(e *yang.Entry, v interface{}, path string, warn func(path string, from, to interface{})) bool {
+	if members, ok := v.([]interface{}); ok && e.IsList() {
+		changed := false
+		for i, m := range members {
+			changed = clampRanges(e, m, fmt.Sprintf("%s[%d]", path, i), warn) || changed
+		}
+		return changed
+	}
+	obj, _ := v.(map[string]interface{})
+	changed := false
+	for k, cv := range obj {
+		name := k
+		if i := strings.Index(k, ":"); i >= 0 {
+			name = k[i+1:]
+		}
+		child := childEntry(e, name)
+		switch {
+		case child == nil:
+		case child.IsDir():
+			changed = clampRanges(child, cv, path+"/"+name, warn) || changed
+		case child.IsLeafList():
+			values, _ := cv.([]interface{})
+			for i, lv := range values {
+				if to, ok := clampValue(child.Type, lv); ok {
+					warn(fmt.Sprintf("%s/%s[%d]", path, name, i), lv, to)
+					values[i] = to
+					changed = true
+				}
+			}
+		default:
+			if to, ok := clampValue(child.Type, cv); ok {
+				warn(path+"/"+name, cv, to)
+				obj[k] = to
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+// clampValue returns the boundary of the range of t nearest to v, and true,
+// if v is an integer or decimal64 JSON value outside that range. Values given
+// as strings, as RFC7951 encodes 64-bit integers and decimals, are returned as
+// strings.
+func clampValue(t *yang.YangType, v interface{}) (interface{}, bool) {
+	s, isString := v.(string)
+	if num, ok := v.(json.Number); ok {
+		s = num.String()
+	} else if !isString {
+		return nil, false
+	}
+
+	var (
+		n   yang.Number
+		err error
+	)
+	switch t.Kind {
+	case yang.Yint8, yang.Yint16, yang.Yint32, yang.Yint64, yang.Yuint8, yang.Yuint16, yang.Yuint32, yang.Yuint64:
+		n, err = yang.ParseInt(s)
+	case yang.Ydecimal64:
+		n, err = yang.ParseDecimal(s, uint8(t.FractionDigits))
+	default:
+		return nil, false
+	}
+	if err != nil || len(t.Range) == 0 {
+		return nil, false
+	}
+
+	if inRange(t, n) {
+		return nil, false
+	}
+
+	f, _ := strconv.ParseFloat(n.String(), 64)
+	var nearest yang.Number
+	dist := math.Inf(1)
+	for _, r := range t.Range {
+		for _, b := range []yang.Number{r.Min, r.Max} {
+			bf, _ := strconv.ParseFloat(b.String(), 64)
+			if d := math.Abs(f - bf); d < dist {
+				nearest, dist = b, d
+			}
+		}
+	}
+	if isString {
+		return nearest.String(), true
+	}
+	return json.Number(nearest.String()), true
+}
+
+// inRange reports whether n is within one of the ranges of t. A type without
+// a range accepts any value.
+func inRange(t *yang.YangType, n yang.Number) bool {
+	if len(t.Range) == 0 {
+		return true
+	}
+	for _, r := range t.Range {
+		if !n.Less(r.Min) && !r.Max.Less(n) {
+			return true
+		}
+	}
+	return false
+}
+
+// quoteNumbers replaces the JSON number in v, or the numbers of the JSON
+// array in v, with strings, and reports whether it changed anything.
+func quoteNumbers(v *interface{}) bool {
+	switch x := (*v).(type) {
+	case json.Number:
+		*v = x.String()
+		return true
+	case []interface{}:
+		changed := false
+		for i := range x {
+			changed = quoteNumbers(&x[i]) || changed
+		}
+		return changed
+	}
+	return false
+}
+
+// enumNames returns the names of the enumeration in t, or of every
+// enumeration within a union.
+func enumNames(t *yang.YangType) []string {
+	if t == nil {
+		return nil
+	}
+	if t.Kind == yang.Yenum {
+		return t.Enum.Names()
+	}
+	var names []string
+	for _, ut := range t.Type {
+		names = append(names, enumNames(ut)...)
+	}
+	return names
+}
+
+// stringPatterns returns the patterns of the string members of the union t,
+// which are the alternatives to its enumerations.
+func stringPatterns(t *yang.YangType) []string {
+	var patterns []string
+	for _, ut := range t.Type {
+		if ut.Kind == yang.Ystring {
+			patterns = append(patterns, ut.Pattern...)
+		}
+		patterns = append(patterns, stringPatterns(ut)...)
+	}
+	return patterns
+}
+
+// withPosition adds the line and column to err if it is a JSON syntax error
+// found in data. A json.Decoder reports input that ends too early, or is
+// empty, as io.ErrUnexpectedEOF or io.EOF rather than as a syntax error;
+// those are placed at the end of data.
+func withPosition(data []byte, err error) error {
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		line, col := position(data, syntaxErr.Offset)
+		return fmt.Errorf("invalid JSON at line %d col %d: %w", line, col, err)
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		line, col := position(data, int64(len(data)))
+		return fmt.Errorf("invalid JSON at line %d col %d: unexpected end of JSON input: %w", line, col, err)
+	}
+	return err
+}
+
+// position converts the offset reported by encoding/json, which points just
+// past the offending byte, into a 1-based line and column.
+func position(data []byte, offset int64) (line, col int) {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	if offset > 0 {
+		offset--
+	}
+	before := data[:offset]
+	line = 1 + bytes.Count(before, []byte("\n"))
+	col = len(before) - bytes.LastIndexByte(before, '\n')
+	return line, col
+}
+
+// UnmarshalWithPresence unmarshals data into d like Parse, and returns the
+// sorted schema paths of the leaves and leaf-lists present in data. This tells
+// a leaf explicitly set to its zero value, such as "mtu": 0, from an absent
+// one.
+func UnmarshalWithPresence(data []byte, d *Device) (presence []string, err error) {
+	if err := Parse(data, d); err != nil {
+		return nil, err
+	}
+	var tree interface{}
+	if err := json.Unmarshal(data, &tree); err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	collectPresence(SchemaTree["Device"], tree, "", seen)
+	for p := range seen {
+		presence = append(presence, p)
+	}
+	sort.Strings(presence)
+	return presence, nil
+}
+
+// collectPresence adds to seen the paths of the leaves and leaf-lists of the
+// JSON value v, which is an instance of the container or list e at path.
+func collectPresence(e *yang.Entry, v interface{}, path string, seen map[string]bool) {
+	if members, ok := v.([]interface{}); ok && e.IsList() {
+		for _, m := range members {
+			collectPresence(e, m, path, seen)
+		}
+		return
+	}
+	obj, _ := v.(map[string]interface{})
+	for k, cv := range obj {
+		name := k
+		if i := strings.Index(k, ":"); i >= 0 {
+			name = k[i+1:]
+		}
+		child := childEntry(e, name)
+		if child == nil {
+			continue
+		}
+		if child.IsDir() {
+			collectPresence(child, cv, path+"/"+name, seen)
+			continue
+		}
+		seen[path+"/"+name] = true
+	}
+}
@@ -0,0 +1,291 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+func TestParseSyntaxErrorPosition(t *testing.T) {
+	tests := []struct {
+		desc      string
+		in        string
+		want      string
+		truncated bool
+	}{{
+		desc: "missing comma",
+		in:   "{\n  \"interface\": {\n    \"name\": \"eth0\"\n    \"mtu\": 1500\n  }\n}",
+		want: "invalid JSON at line 4 col 5",
+	}, {
+		desc: "bad value on first line",
+		in:   `{"interface": x}`,
+		want: "invalid JSON at line 1 col 15",
+	}, {
+		desc:      "truncated",
+		in:        "{\n  \"interface\": {",
+		want:      "invalid JSON at line 2 col 16: unexpected end of JSON input",
+		truncated: true,
+	}, {
+		desc:      "empty",
+		in:        "",
+		want:      "invalid JSON at line 1 col 1: unexpected end of JSON input",
+		truncated: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := Parse([]byte(tt.in), &Device{})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Parse() error = %v, want %q", err, tt.want)
+			}
+			if tt.truncated {
+				if !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+					t.Errorf("Parse() error = %v, want io.ErrUnexpectedEOF or io.EOF", err)
+				}
+				return
+			}
+			var syntaxErr *json.SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Errorf("Parse() error = %v, want a *json.SyntaxError", err)
+			}
+		})
+	}
+}
+
+func TestParseTrailingData(t *testing.T) {
+	for _, in := range []string{
+		`{"interface": {"load-factor": 0.5}} garbage`,
+		`{"interface": {"load-factor": 0.5}} {}`,
+		`{"interface": {"mtu": 1500}} garbage`,
+	} {
+		if err := Parse([]byte(in), &Device{}); err == nil {
+			t.Errorf("Parse(%s) error = nil, want an error for the trailing data", in)
+		}
+	}
+}
+
+func TestParseDeviceSkipValidation(t *testing.T) {
+	in := []byte(`{"interface": {"name": "eth0", "priority": 7}}`)
+
+	if err := ParseDevice(in, &Device{}); !errors.Is(err, ErrValidation) {
+		t.Errorf("ParseDevice(priority 7) error = %v, want ErrValidation", err)
+	}
+
+	var d Device
+	if err := ParseDevice(in, &d, SkipValidation()); err != nil {
+		t.Fatalf("ParseDevice(priority 7, SkipValidation()) error = %v", err)
+	}
+	if got := d.GetInterface().GetPriority(); got != 7 {
+		t.Errorf("ParseDevice(priority 7, SkipValidation()) priority = %d, want 7", got)
+	}
+	if err := ValidateDevice(&d); !errors.Is(err, ErrValidation) {
+		t.Errorf("ValidateDevice(priority 7) error = %v, want ErrValidation", err)
+	}
+	d.Interface.Priority = ygot.Uint8(3)
+	if err := ValidateDevice(&d); err != nil {
+		t.Errorf("ValidateDevice(priority 3) error = %v", err)
+	}
+
+	// The option does not relax the types of the values.
+	if err := ParseDevice([]byte(`{"interface": {"priority": "high"}}`), &Device{}, SkipValidation()); err == nil {
+		t.Error("ParseDevice(priority high, SkipValidation()) error = nil, want an error")
+	}
+}
+
+func TestParseInvalidEnum(t *testing.T) {
+	err := Parse([]byte(`{"interface": {"status": "bogus"}}`), &Device{})
+	if err == nil {
+		t.Fatal("Parse() error = nil, want an error for status bogus")
+	}
+	for _, name := range []string{"bogus", "up", "down", "testing", "maintenance-.*"} {
+		if !strings.Contains(err.Error(), name) {
+			t.Errorf("Parse() error = %v, want it to mention %q", err, name)
+		}
+	}
+}
+
+func TestUnmarshalWithPresence(t *testing.T) {
+	for _, tt := range []struct {
+		desc string
+		in   string
+		want []string
+	}{
+		{"name", `{"interface": {"name": "eth0"}}`, []string{"/interface/name"}},
+		{"name and mtu", `{"interface": {"name": "eth0", "mtu": 1500}}`, []string{"/interface/mtu", "/interface/name"}},
+		{"empty", `{}`, nil},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			var d Device
+			got, err := UnmarshalWithPresence([]byte(tt.in), &d)
+			if err != nil {
+				t.Fatalf("UnmarshalWithPresence() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UnmarshalWithPresence() = %v, want %v", got, tt.want)
+			}
+			if d.GetInterface().GetName() != "eth0" && tt.want != nil {
+				t.Errorf("UnmarshalWithPresence() name = %q, want eth0", d.GetInterface().GetName())
+			}
+		})
+	}
+}
+
+func TestUnmarshalWithPresenceZeroValue(t *testing.T) {
+	var d Device
+	got, err := UnmarshalWithPresence([]byte(`{"interface": {"mtu": 0}}`), &d)
+	if err != nil {
+		t.Fatalf("UnmarshalWithPresence() error = %v", err)
+	}
+	if len(got) != 1 || got[0] != "/interface/mtu" {
+		t.Errorf("UnmarshalWithPresence(mtu 0) = %v, want [/interface/mtu]", got)
+	}
+}
+
+// recordHandler is a slog.Handler that keeps the records it handles.
+type recordHandler struct {
+	records []slog.Record
+}
+
+func (h *recordHandler) Enabled(context.Context, slog.Level) bool { return true }
+
+func (h *recordHandler) Handle(_ context.Context, r slog.Record) error {
+	h.records = append(h.records, r)
+	return nil
+}
+
+func (h *recordHandler) WithAttrs([]slog.Attr) slog.Handler { return h }
+
+func (h *recordHandler) WithGroup(string) slog.Handler { return h }
+
+func TestParseWithLogger(t *testing.T) {
+	in := []byte(`{"interface": {"name": "eth0", "mtu": 1500, "load-factor": 0.5}}`)
+	h := &recordHandler{}
+
+	var d Device
+	if err := ParseDevice(in, &d, WithLogger(slog.New(h))); err != nil {
+		t.Fatalf("ParseDevice(WithLogger()) error = %v", err)
+	}
+	if len(h.records) != 2 {
+		t.Fatalf("ParseDevice(WithLogger()) logged %d records, want 2", len(h.records))
+	}
+	if r := h.records[0]; !strings.Contains(r.Message, "decimal64") {
+		t.Errorf("first record = %q, want the decimal64 fix-up", r.Message)
+	}
+	summary := h.records[1]
+	if summary.Message != "parsed device" || summary.Level != slog.LevelDebug {
+		t.Fatalf("last record = %v %q, want DEBUG \"parsed device\"", summary.Level, summary.Message)
+	}
+	var leaves int64
+	summary.Attrs(func(a slog.Attr) bool {
+		if a.Key == "leaves" {
+			leaves = a.Value.Int64()
+		}
+		return true
+	})
+	if leaves != 3 {
+		t.Errorf("parsed device leaves = %d, want 3", leaves)
+	}
+
+	// Without a logger, the result is the same.
+	var quiet Device
+	if err := ParseDevice(in, &quiet); err != nil {
+		t.Fatalf("ParseDevice() error = %v", err)
+	}
+	if !reflect.DeepEqual(&quiet, &d) {
+		t.Errorf("ParseDevice() = %v, want %v as with WithLogger()", &quiet, &d)
+	}
+
+	// Failed parses have no summary.
+	h.records = nil
+	if err := Parse([]byte(`{"interface": {"bogus": 1}}`), &Device{}, WithLogger(slog.New(h))); err == nil {
+		t.Fatal("Parse(bogus) error = nil, want an error")
+	}
+	if len(h.records) != 0 {
+		t.Errorf("Parse(bogus) logged %d records, want none", len(h.records))
+	}
+}
+
+func TestParseDeviceClampRanges(t *testing.T) {
+	for _, tt := range []struct {
+		desc string
+		in   string
+		want func(*NetworkDevice_Interface) interface{}
+		val  interface{}
+	}{
+		{"mtu above range", `{"interface": {"mtu": 100000}}`, func(i *NetworkDevice_Interface) interface{} { return i.GetMtu() }, uint16(65535)},
+		{"mtu below range", `{"interface": {"mtu": 10}}`, func(i *NetworkDevice_Interface) interface{} { return i.GetMtu() }, uint16(68)},
+		{"priority in gap", `{"interface": {"priority": 7}}`, func(i *NetworkDevice_Interface) interface{} { return i.GetPriority() }, uint8(5)},
+		{"priority below range", `{"interface": {"priority": 0}}`, func(i *NetworkDevice_Interface) interface{} { return i.GetPriority() }, uint8(1)},
+		{"load-factor above range", `{"interface": {"load-factor": "1.5"}}`, func(i *NetworkDevice_Interface) interface{} { return i.GetLoadFactor() }, 1.0},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			if err := ParseDevice([]byte(tt.in), &Device{}); err == nil {
+				t.Errorf("ParseDevice(%s) error = nil, want an error", tt.in)
+			}
+
+			h := &recordHandler{}
+			var d Device
+			if err := ParseDevice([]byte(tt.in), &d, ClampRanges(), WithLogger(slog.New(h))); err != nil {
+				t.Fatalf("ParseDevice(%s, ClampRanges()) error = %v", tt.in, err)
+			}
+			if got := tt.want(d.GetInterface()); got != tt.val {
+				t.Errorf("ParseDevice(%s, ClampRanges()) = %v, want %v", tt.in, got, tt.val)
+			}
+			var warned bool
+			for _, r := range h.records {
+				warned = warned || r.Level == slog.LevelWarn
+			}
+			if !warned {
+				t.Errorf("ParseDevice(%s, ClampRanges()) logged no warning", tt.in)
+			}
+		})
+	}
+
+	// Without a logger, the warnings are collected.
+	var warnings []ClampWarning
+	var d Device
+	if err := ParseDevice([]byte(`{"interface": {"mtu": 100000, "priority": 7}}`), &d, ClampRangesInto(&warnings)); err != nil {
+		t.Fatalf("ParseDevice(ClampRangesInto()) error = %v", err)
+	}
+	if d.GetInterface().GetMtu() != 65535 || d.GetInterface().GetPriority() != 5 {
+		t.Errorf("ParseDevice(ClampRangesInto()) = %v, want mtu 65535 and priority 5", d.GetInterface())
+	}
+	sort.Slice(warnings, func(i, j int) bool { return warnings[i].Path < warnings[j].Path })
+	want := []ClampWarning{
+		{Path: "/interface/mtu", Value: json.Number("100000"), Clamped: json.Number("65535")},
+		{Path: "/interface/priority", Value: json.Number("7"), Clamped: json.Number("5")},
+	}
+	if !reflect.DeepEqual(warnings, want) {
+		t.Errorf("ParseDevice(ClampRangesInto()) warnings = %v, want %v", warnings, want)
+	}
+
+	// Values in range are left alone.
+	d = Device{}
+	if err := ParseDevice([]byte(`{"interface": {"mtu": 9000, "priority": 12}}`), &d, ClampRanges()); err != nil {
+		t.Fatalf("ParseDevice(in range, ClampRanges()) error = %v", err)
+	}
+	if d.GetInterface().GetMtu() != 9000 || d.GetInterface().GetPriority() != 12 {
+		t.Errorf("ParseDevice(in range, ClampRanges()) = %v, want mtu 9000 and priority 12", d.GetInterface())
+	}
+}
+
+func TestTruncatedInputPosition(t *testing.T) {
+	in := []byte(`{"interface": {`)
+	want := "invalid JSON at line 1 col 15: unexpected end of JSON input"
+	for name, err := range map[string]error{
+		"ValidateJSON":   ValidateJSON(in),
+		"FromLegacyJSON": FromLegacyJSON(in, &Device{}),
+		"RESTCONFPatch":  RESTCONFPatch(&Device{}, "/restconf/data/network-device:interface", in),
+	} {
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%s() error = %v, want %q", name, err, want)
+		}
+	}
+}
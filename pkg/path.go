@@ -0,0 +1,129 @@
+package network
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/util"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// IsSet reports whether the leaf at path, a gNMI-style path such as
+// /interface/mtu, is populated in d. It returns an error if path does not
+// exist in the schema.
+func IsSet(d *Device, path string) (bool, error) {
+	p, _, err := resolvePath(path)
+	if err != nil {
+		return false, err
+	}
+	nodes, err := ytypes.GetNode(SchemaTree["Device"], d, p)
+	if status.Code(err) == codes.NotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	for _, n := range nodes {
+		if !util.IsValueNilOrDefault(n.Data) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// resolvePath parses path, a gNMI-style path such as /interface/mtu, and
+// returns it together with the schema entry of the node it points to. Path
+// elements may be qualified with their module name, e.g.
+// /network-device:interface/mtu.
+func resolvePath(path string) (*gnmi.Path, *yang.Entry, error) {
+	p, err := ygot.StringToStructuredPath(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid path %q: %w", path, err)
+	}
+	e := SchemaTree["Device"]
+	for _, elem := range p.GetElem() {
+		name := elem.GetName()
+		if i := strings.Index(name, ":"); i >= 0 {
+			name = name[i+1:]
+			elem.Name = name
+		}
+		child := childEntry(e, name)
+		if child == nil {
+			return nil, nil, fmt.Errorf("unknown path %q: no node %q under %s", path, name, e.Path())
+		}
+		e = child
+	}
+	return p, e, nil
+}
+
+// childEntry returns the data node called name below e, looking through any
+// choice and case statements, which do not appear in data paths. It returns
+// nil if there is no such node.
+func childEntry(e *yang.Entry, name string) *yang.Entry {
+	if child, ok := e.Dir[name]; ok && !child.IsChoice() && !child.IsCase() {
+		return child
+	}
+	for _, child := range e.Dir {
+		if !child.IsChoice() && !child.IsCase() {
+			continue
+		}
+		if found := childEntry(child, name); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// Subtree returns the GoStruct rooted at path within d, e.g. /interface
+// returns d's *NetworkDevice_Interface. The result shares memory with d and can
+// be emitted on its own. It returns an error if path is not a container or
+// list member, or if it is not populated.
+func Subtree(d *Device, path string) (ygot.GoStruct, error) {
+	p, e, err := resolvePath(path)
+	if err != nil {
+		return nil, err
+	}
+	if !e.IsDir() {
+		return nil, fmt.Errorf("%s is not a container or list", path)
+	}
+	nodes, err := ytypes.GetNode(SchemaTree["Device"], d, p)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get %s: %w", path, err)
+	}
+	if len(nodes) != 1 {
+		return nil, fmt.Errorf("%s matches %d nodes, want 1", path, len(nodes))
+	}
+	s, ok := nodes[0].Data.(ygot.GoStruct)
+	if !ok || util.IsValueNil(s) {
+		return nil, fmt.Errorf("%s is not populated", path)
+	}
+	return s, nil
+}
+
+// Clear unsets the node at path within d, e.g. /interface/mtu. Containers of
+// d left without any populated leaf are then removed, as Prune does.
+func Clear(d *Device, path string) error {
+	p, _, err := resolvePath(path)
+	if err != nil {
+		return err
+	}
+	if err := ytypes.DeleteNode(SchemaTree["Device"], d, p); err != nil {
+		return fmt.Errorf("cannot clear %s: %w", path, err)
+	}
+	Prune(d)
+	return nil
+}
+
+// Reset unsets every node of d, releasing its subtrees, so that d can be
+// reused as if it had just been allocated. A nil device is left as is.
+func Reset(d *Device) {
+	if d == nil {
+		return
+	}
+	*d = Device{}
+}
@@ -0,0 +1,129 @@
+package network
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+func TestIsSet(t *testing.T) {
+	d := &Device{}
+	if got, err := IsSet(d, "/interface/mtu"); err != nil || got {
+		t.Errorf("IsSet(fresh device) = %v, %v, want false, nil", got, err)
+	}
+	d.GetOrCreateInterface().Mtu = ygot.Uint16(1500)
+	if got, err := IsSet(d, "/interface/mtu"); err != nil || !got {
+		t.Errorf("IsSet() after assignment = %v, %v, want true, nil", got, err)
+	}
+	if got, err := IsSet(d, "/interface/name"); err != nil || got {
+		t.Errorf("IsSet(/interface/name) = %v, %v, want false, nil", got, err)
+	}
+	if _, err := IsSet(d, "/interface/speed-mbps"); err == nil {
+		t.Error("IsSet(unknown path) error = nil, want an error")
+	}
+}
+
+func TestSubtree(t *testing.T) {
+	d := augmentDevice()
+	s, err := Subtree(d, "/interface")
+	if err != nil {
+		t.Fatalf("Subtree() error = %v", err)
+	}
+	iface, ok := s.(*NetworkDevice_Interface)
+	if !ok || iface != d.Interface {
+		t.Fatalf("Subtree() = %T, want the interface of d", s)
+	}
+	out, err := ygot.EmitJSON(iface, &ygot.EmitJSONConfig{Format: ygot.RFC7951})
+	if err != nil {
+		t.Fatalf("EmitJSON(subtree) error = %v", err)
+	}
+	got := &NetworkDevice_Interface{}
+	if err := Unmarshal([]byte(out), got); err != nil {
+		t.Fatalf("Unmarshal(%s) error = %v", out, err)
+	}
+	if got.GetMtu() != 1500 || got.GetStatus() != NetworkDevice_Interface_Status_up {
+		t.Errorf("emitted subtree = %s, want the mtu and status of d", out)
+	}
+}
+
+func TestSubtreeErrors(t *testing.T) {
+	for _, path := range []string{"/interface/mtu", "/interface/state", "/nope"} {
+		if _, err := Subtree(augmentDevice(), path); err == nil {
+			t.Errorf("Subtree(%s) error = nil, want an error", path)
+		}
+	}
+}
+
+func TestClearMethods(t *testing.T) {
+	d := augmentDevice()
+	d.Interface.ClearMtu()
+	d.Interface.ClearName()
+	d.Interface.ClearPriority()
+	d.Interface.ClearBandwidth()
+
+	out := emitRFC7951(t, d)
+	for _, leaf := range []string{"mtu", "name", "priority", "bandwidth"} {
+		if strings.Contains(out, `"`+leaf+`"`) {
+			t.Errorf("EmitJSON() = %s, want no %s", out, leaf)
+		}
+		if set, _ := IsSet(d, "/interface/"+leaf); set {
+			t.Errorf("IsSet(/interface/%s) = true after clearing it", leaf)
+		}
+	}
+	if set, _ := IsSet(d, "/interface/status"); !set {
+		t.Error("IsSet(/interface/status) = false, want it untouched")
+	}
+}
+
+func TestClear(t *testing.T) {
+	d := augmentDevice()
+	if err := Clear(d, "/interface/mtu"); err != nil {
+		t.Fatalf("Clear() error = %v", err)
+	}
+	if set, _ := IsSet(d, "/interface/mtu"); set {
+		t.Error("IsSet(/interface/mtu) = true after Clear")
+	}
+	if out := emitRFC7951(t, d); strings.Contains(out, `"mtu"`) {
+		t.Errorf("EmitJSON() = %s, want no mtu", out)
+	}
+	if err := Clear(d, "/interface/speed-mbps"); err == nil {
+		t.Error("Clear(unknown path) error = nil, want an error")
+	}
+}
+
+func TestClearPrunesEmptyContainers(t *testing.T) {
+	d := &Device{}
+	d.GetOrCreateInterface().Mtu = ygot.Uint16(1500)
+	if err := Clear(d, "/interface/mtu"); err != nil {
+		t.Fatalf("Clear() error = %v", err)
+	}
+	if d.Interface != nil {
+		t.Errorf("Clear() of the last leaf left interface %v, want nil", d.Interface)
+	}
+}
+
+func TestReset(t *testing.T) {
+	d := augmentDevice()
+	d.GetOrCreateSystem().NtpServer = []string{"10.0.0.1"}
+	d.Interface.GetOrCreateTunnel()
+	Reset(d)
+
+	if err := Walk(d, func(path string, _ interface{}) error {
+		t.Errorf("Reset() left %s set", path)
+		return nil
+	}); err != nil {
+		t.Fatalf("Walk() error = %v", err)
+	}
+	if d.Interface != nil || d.System != nil {
+		t.Errorf("Reset() left containers %v, %v, want nil", d.Interface, d.System)
+	}
+	if got, want := d.Validate(), (&Device{}).Validate(); (got == nil) != (want == nil) {
+		t.Errorf("Validate() after Reset() = %v, want %v as for a new device", got, want)
+	}
+	if emitRFC7951(t, d) != emitRFC7951(t, &Device{}) {
+		t.Errorf("EmitJSON() after Reset() = %s, want it as for a new device", emitRFC7951(t, d))
+	}
+
+	Reset(nil)
+}
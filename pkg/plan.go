@@ -0,0 +1,84 @@
+package network
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+)
+
+// PlanStep is a single change of a reconciliation plan returned by Plan.
+type PlanStep struct {
+	// Path is the path of the leaf to change, e.g. /interface/mtu.
+	Path string
+	// Value is the value to set the leaf to, or nil to delete it.
+	Value *gnmi.TypedValue
+}
+
+// planDependencies lists, for the leaves whose update must wait for others,
+// the leaves that have to be updated first. Links take the MTU and speed they
+// come up with, so those are set before the interface is enabled, and a
+// vlan-id is only valid once the interface is enabled.
+var planDependencies = map[string][]string{
+	"/interface/enabled": {"/interface/mtu", "/interface/speed", "/interface/auto-negotiate"},
+	"/interface/vlan-id": {"/interface/enabled"},
+}
+
+// Plan returns the steps that turn current into desired, in an order that
+// respects planDependencies. Deletes come first, so that leaves which depend
+// on others, such as vlan-id on enabled, are gone before those change. Steps
+// that are otherwise unordered are sorted by path.
+func Plan(current, desired *Device) ([]PlanStep, error) {
+	n, err := ygot.Diff(current, desired)
+	if err != nil {
+		return nil, fmt.Errorf("cannot diff devices: %w", err)
+	}
+
+	var deletes []PlanStep
+	for _, p := range n.GetDelete() {
+		s, err := ygot.PathToString(p)
+		if err != nil {
+			return nil, err
+		}
+		deletes = append(deletes, PlanStep{Path: s})
+	}
+	sort.Slice(deletes, func(i, j int) bool { return deletes[i].Path < deletes[j].Path })
+
+	pending := map[string]*gnmi.TypedValue{}
+	for _, u := range n.GetUpdate() {
+		s, err := ygot.PathToString(u.GetPath())
+		if err != nil {
+			return nil, err
+		}
+		pending[s] = u.GetVal()
+	}
+	updates := make([]PlanStep, 0, len(pending))
+	for len(pending) > 0 {
+		var ready []string
+		for path := range pending {
+			if !waiting(path, pending) {
+				ready = append(ready, path)
+			}
+		}
+		if len(ready) == 0 {
+			return nil, fmt.Errorf("cannot order updates: cyclic dependencies between %d leaves", len(pending))
+		}
+		sort.Strings(ready)
+		// Emit one step at a time, so that steps without dependencies
+		// between them stay sorted by path.
+		updates = append(updates, PlanStep{Path: ready[0], Value: pending[ready[0]]})
+		delete(pending, ready[0])
+	}
+	return append(deletes, updates...), nil
+}
+
+// waiting reports whether the update of path depends on one still pending.
+func waiting(path string, pending map[string]*gnmi.TypedValue) bool {
+	for _, dep := range planDependencies[path] {
+		if _, ok := pending[dep]; ok {
+			return true
+		}
+	}
+	return false
+}
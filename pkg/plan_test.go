@@ -0,0 +1,63 @@
+package network
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+func TestPlan(t *testing.T) {
+	current := augmentDevice()
+	current.Interface.Enabled = ygot.Bool(false)
+
+	desired := augmentDevice()
+	desired.Interface.Enabled = ygot.Bool(true)
+	desired.Interface.Mtu = ygot.Uint16(9000)
+	desired.Interface.VlanId = ygot.Uint16(100)
+	desired.Interface.Bandwidth = nil
+	desired.Interface.Description = ygot.String("uplink")
+
+	steps, err := Plan(current, desired)
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+	var got []string
+	for _, s := range steps {
+		got = append(got, s.Path)
+	}
+	// enabled sorts before mtu, but has to wait for it, and vlan-id for
+	// enabled.
+	want := []string{"/interface/bandwidth", "/interface/description", "/interface/mtu", "/interface/enabled", "/interface/vlan-id"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Plan() paths = %q, want %q", got, want)
+	}
+	if steps[0].Value != nil {
+		t.Errorf("Plan() bandwidth step = %v, want a delete", steps[0].Value)
+	}
+	if got := steps[2].Value.GetUintVal(); got != 9000 {
+		t.Errorf("Plan() mtu step value = %d, want 9000", got)
+	}
+	if got := steps[3].Value.GetBoolVal(); !got {
+		t.Errorf("Plan() enabled step value = %v, want true", got)
+	}
+
+	// Without a pending mtu change, enabled has nothing to wait for.
+	current.Interface.Mtu = ygot.Uint16(9000)
+	steps, err = Plan(current, desired)
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+	got = nil
+	for _, s := range steps {
+		got = append(got, s.Path)
+	}
+	want = []string{"/interface/bandwidth", "/interface/description", "/interface/enabled", "/interface/vlan-id"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Plan() paths = %q, want %q", got, want)
+	}
+
+	if steps, err := Plan(desired, desired); err != nil || len(steps) != 0 {
+		t.Errorf("Plan(desired, desired) = %v, %v, want no steps", steps, err)
+	}
+}
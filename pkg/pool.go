@@ -0,0 +1,31 @@
+package network
+
+import "sync"
+
+// DevicePool is a pool of devices, backed by a sync.Pool, for programs that
+// unmarshal many devices in a row, such as a Decoder loop. Devices are reset
+// when they are put back, so Get always returns an empty device. Only the
+// Device itself is reused, as Reset releases its subtrees. The zero value is
+// ready to use.
+type DevicePool struct {
+	p sync.Pool
+}
+
+// Get returns an empty device from the pool, or a new one if the pool is
+// empty.
+func (p *DevicePool) Get() *Device {
+	if d, ok := p.p.Get().(*Device); ok {
+		return d
+	}
+	return &Device{}
+}
+
+// Put resets d and returns it to the pool. The caller must not use d
+// afterwards.
+func (p *DevicePool) Put(d *Device) {
+	if d == nil {
+		return
+	}
+	Reset(d)
+	p.p.Put(d)
+}
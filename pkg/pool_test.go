@@ -0,0 +1,45 @@
+package network
+
+import "testing"
+
+func TestDevicePool(t *testing.T) {
+	var pool DevicePool
+	for i := 0; i < 10; i++ {
+		d := pool.Get()
+		if err := Walk(d, func(path string, _ interface{}) error {
+			t.Errorf("Get() returned a device with %s set", path)
+			return nil
+		}); err != nil {
+			t.Fatalf("Walk() error = %v", err)
+		}
+		if err := Parse([]byte(`{"interface": {"name": "eth0", "mtu": 1500}}`), d); err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		pool.Put(d)
+	}
+	pool.Put(nil)
+}
+
+func BenchmarkUnmarshal(b *testing.B) {
+	data := []byte(`{"interface": {"name": "eth0", "mtu": 1500, "priority": 12}}`)
+
+	b.Run("new", func(b *testing.B) {
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			if err := Parse(data, &Device{}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("pool", func(b *testing.B) {
+		b.ReportAllocs()
+		var pool DevicePool
+		for n := 0; n < b.N; n++ {
+			d := pool.Get()
+			if err := Parse(data, d); err != nil {
+				b.Fatal(err)
+			}
+			pool.Put(d)
+		}
+	})
+}
@@ -0,0 +1,48 @@
+package network
+
+import (
+	"fmt"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+)
+
+// SensitivePaths lists the string leaves that Redacted masks. goyang does not
+// keep extension statements in the generated schema, so they are listed here
+// rather than tagged in the YANG model.
+var SensitivePaths = []string{
+	"/interface/password",
+}
+
+// redactedValue replaces the value of sensitive leaves.
+const redactedValue = "***"
+
+// Redacted returns a copy of d, safe to log, in which every populated leaf of
+// SensitivePaths is replaced by "***". d is not modified.
+func Redacted(d *Device) (*Device, error) {
+	cp, err := ygot.DeepCopy(d)
+	if err != nil {
+		return nil, fmt.Errorf("cannot copy device: %w", err)
+	}
+	out := cp.(*Device)
+
+	for _, path := range SensitivePaths {
+		set, err := IsSet(out, path)
+		if err != nil {
+			return nil, err
+		}
+		if !set {
+			continue
+		}
+		p, _, err := resolvePath(path)
+		if err != nil {
+			return nil, err
+		}
+		val := &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: redactedValue}}
+		if err := ytypes.SetNode(SchemaTree["Device"], out, p, val); err != nil {
+			return nil, fmt.Errorf("cannot redact %s: %w", path, err)
+		}
+	}
+	return out, nil
+}
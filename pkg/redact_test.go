@@ -0,0 +1,36 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+func TestRedacted(t *testing.T) {
+	d := augmentDevice()
+	d.Interface.Password = ygot.String("s3cret")
+
+	r, err := Redacted(d)
+	if err != nil {
+		t.Fatalf("Redacted() error = %v", err)
+	}
+	if got := r.GetInterface().GetPassword(); got != "***" {
+		t.Errorf("redacted password = %q, want ***", got)
+	}
+	if got := r.GetInterface().GetMtu(); got != 1500 {
+		t.Errorf("redacted mtu = %d, want 1500", got)
+	}
+	if got := d.GetInterface().GetPassword(); got != "s3cret" {
+		t.Errorf("original password = %q after Redacted, want s3cret", got)
+	}
+}
+
+func TestRedactedUnset(t *testing.T) {
+	r, err := Redacted(augmentDevice())
+	if err != nil {
+		t.Fatalf("Redacted() error = %v", err)
+	}
+	if r.GetInterface().Password != nil {
+		t.Errorf("redacted password = %q, want it left unset", r.GetInterface().GetPassword())
+	}
+}
@@ -0,0 +1,240 @@
+package network
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+)
+
+// restconfDataRoot is the RESTCONF datastore resource (RFC 8040, section 3.3).
+const restconfDataRoot = "/restconf/data"
+
+// FromRESTCONFPath converts a RESTCONF data resource URI, such as
+// /restconf/data/network-device:interface/mtu, into a gNMI path. Every node
+// must exist in the schema, and a module prefix must name the module that
+// defines the node. List instances, written as list=key1,key2, become path
+// keys named after the list keys.
+func FromRESTCONFPath(uri string) (*gnmi.Path, error) {
+	rest := strings.Trim(strings.TrimPrefix(uri, restconfDataRoot), "/")
+	p := &gnmi.Path{}
+	if rest == "" {
+		return p, nil
+	}
+
+	e, t := SchemaTree["Device"], reflect.TypeOf(Device{})
+	for _, seg := range strings.Split(rest, "/") {
+		name, keys, hasKeys := strings.Cut(seg, "=")
+		// The first node, and any node from a different module, carries
+		// the module name as a prefix.
+		prefix, local, qualified := strings.Cut(name, ":")
+		if qualified {
+			name = local
+		}
+		child := childEntry(e, name)
+		if child == nil {
+			return nil, fmt.Errorf("unknown node %q in %q", name, uri)
+		}
+		var module string
+		module, t = nodeModule(t, name)
+		if qualified && prefix != module {
+			return nil, fmt.Errorf("node %q in %q is defined by module %q, not %q", name, uri, module, prefix)
+		}
+
+		elem := &gnmi.PathElem{Name: name}
+		if hasKeys {
+			if !child.IsList() {
+				return nil, fmt.Errorf("node %q in %q is not a list, cannot have keys", name, uri)
+			}
+			keyNames := strings.Fields(child.Key)
+			values := strings.Split(keys, ",")
+			if len(values) != len(keyNames) {
+				return nil, fmt.Errorf("list %q in %q needs %d keys, got %d", name, uri, len(keyNames), len(values))
+			}
+			elem.Key = map[string]string{}
+			for i, k := range keyNames {
+				v, err := url.PathUnescape(values[i])
+				if err != nil {
+					return nil, fmt.Errorf("invalid key %q for list %q: %w", values[i], name, err)
+				}
+				elem.Key[k] = v
+			}
+		}
+		p.Elem = append(p.Elem, elem)
+		e = child
+	}
+	return p, nil
+}
+
+// nodeModule returns the module that defines the node name of the GoStruct
+// type t, as given by the module tag of its field, and the GoStruct type of the
+// node, which is nil for a leaf or an unknown node.
+func nodeModule(t reflect.Type, name string) (string, reflect.Type) {
+	if t == nil {
+		return "", nil
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Tag.Get("path") != name {
+			continue
+		}
+		ft := f.Type
+		if ft.Kind() == reflect.Map {
+			ft = ft.Elem()
+		}
+		if ft.Kind() == reflect.Ptr && ft.Elem().Kind() == reflect.Struct {
+			return f.Tag.Get("module"), ft.Elem()
+		}
+		return f.Tag.Get("module"), nil
+	}
+	return "", nil
+}
+
+// EmitRESTCONF encodes d as RESTCONF JSON, in which every top-level node, and
+// every node from a different module than its parent, is qualified with its
+// module name, e.g. "network-device:interface".
+func EmitRESTCONF(d *Device) ([]byte, error) {
+	tree, err := ygot.ConstructIETFJSON(d, &ygot.RFC7951JSONConfig{AppendModuleName: true})
+	if err != nil {
+		return nil, fmt.Errorf("cannot build RFC7951 tree: %w", err)
+	}
+	return json.MarshalIndent(tree, "", "  ")
+}
+
+// UnmarshalRESTCONF decodes RESTCONF JSON, as produced by EmitRESTCONF, into
+// d. Unqualified names are accepted too.
+func UnmarshalRESTCONF(data []byte, d *Device) error {
+	return Parse(data, d)
+}
+
+// RESTCONFPatch applies body, a RESTCONF plain PATCH request (RFC 8040,
+// section 4.6.1), to the resource of d at targetPath, e.g.
+// /restconf/data/network-device:interface. As in RESTCONF, body holds the
+// target resource, e.g. {"network-device:interface": {"mtu": 9000}}, and is
+// merged into it with JSON merge-patch semantics (RFC 7396): objects are
+// merged member by member, other values replace what is there, and null
+// deletes the node. The result is validated with ValidateDevice, and d is only
+// changed if it is valid.
+func RESTCONFPatch(d *Device, targetPath string, body []byte) error {
+	p, err := FromRESTCONFPath(targetPath)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var patch map[string]interface{}
+	if err := dec.Decode(&patch); err != nil {
+		return withPosition(body, err)
+	}
+	tree, err := ygot.ConstructIETFJSON(d, nil)
+	if err != nil {
+		return fmt.Errorf("cannot build RFC7951 tree: %w", err)
+	}
+
+	if len(p.GetElem()) == 0 {
+		mergePatch(tree, patch)
+	} else {
+		parent := tree
+		elems := p.GetElem()
+		for _, e := range elems[:len(elems)-1] {
+			parent = patchTarget(parent, e)
+		}
+		last := elems[len(elems)-1]
+		var (
+			v  interface{}
+			ok bool
+		)
+		for k, pv := range patch {
+			if localName(k) == last.GetName() {
+				v, ok = pv, true
+			}
+		}
+		if !ok || len(patch) != 1 {
+			return fmt.Errorf("PATCH body for %s must hold %q alone", targetPath, last.GetName())
+		}
+		if last.GetKey() == nil {
+			mergePatch(parent, map[string]interface{}{last.GetName(): v})
+		} else {
+			// A list instance is sent as a list of that one member.
+			members, ok := v.([]interface{})
+			if !ok || len(members) != 1 {
+				return fmt.Errorf("PATCH body for %s must hold a single %q member", targetPath, last.GetName())
+			}
+			m, ok := members[0].(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("PATCH body for %s: %q member is not an object", targetPath, last.GetName())
+			}
+			mergePatch(patchTarget(parent, last), m)
+		}
+	}
+
+	data, err := json.Marshal(tree)
+	if err != nil {
+		return fmt.Errorf("cannot encode patched tree: %w", err)
+	}
+	var patched Device
+	if err := ParseDevice(data, &patched); err != nil {
+		return err
+	}
+	*d = patched
+	return nil
+}
+
+// patchTarget returns the object of the container or list member e within
+// obj, a JSON tree with unqualified names, creating it if it is missing.
+func patchTarget(obj map[string]interface{}, e *gnmi.PathElem) map[string]interface{} {
+	if e.GetKey() == nil {
+		child, ok := obj[e.GetName()].(map[string]interface{})
+		if !ok {
+			child = map[string]interface{}{}
+			obj[e.GetName()] = child
+		}
+		return child
+	}
+	members, _ := obj[e.GetName()].([]interface{})
+	if m, ok := listMember(members, e.GetKey()); ok {
+		return m
+	}
+	m := map[string]interface{}{}
+	for k, v := range e.GetKey() {
+		m[k] = v
+	}
+	obj[e.GetName()] = append(members, m)
+	return m
+}
+
+// mergePatch merges patch into target as RFC 7396 describes. Names in patch
+// may be qualified with their module, those of target are not.
+func mergePatch(target, patch map[string]interface{}) {
+	for k, v := range patch {
+		k = localName(k)
+		if v == nil {
+			delete(target, k)
+			continue
+		}
+		pv, isObject := v.(map[string]interface{})
+		if !isObject {
+			target[k] = v
+			continue
+		}
+		tv, ok := target[k].(map[string]interface{})
+		if !ok {
+			tv = map[string]interface{}{}
+			target[k] = tv
+		}
+		mergePatch(tv, pv)
+	}
+}
+
+// localName returns name without its module prefix, if any.
+func localName(name string) string {
+	if _, local, ok := strings.Cut(name, ":"); ok {
+		return local
+	}
+	return name
+}
@@ -0,0 +1,125 @@
+package network
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+func TestFromRESTCONFPath(t *testing.T) {
+	tests := []struct {
+		desc    string
+		uri     string
+		want    string
+		wantErr bool
+	}{
+		{desc: "container leaf", uri: "/restconf/data/network-device:interface/mtu", want: "/interface/mtu"},
+		{desc: "augmented leaf", uri: "/restconf/data/network-device:interface/network-device-extensions:status", want: "/interface/status"},
+		{desc: "root", uri: "/restconf/data", want: "/"},
+		{desc: "unknown node", uri: "/restconf/data/network-device:interface/speed-mbps", wantErr: true},
+		{desc: "list member", uri: "/restconf/data/network-device:interface/tags/tag=env/value", want: "/interface/tags/tag[key=env]/value"},
+		{desc: "too many keys", uri: "/restconf/data/network-device:interface/tags/tag=env,prod", wantErr: true},
+		{desc: "keys on a container", uri: "/restconf/data/network-device:interface=eth0", wantErr: true},
+		{desc: "unprefixed", uri: "/restconf/data/interface/state/counters/in-octets", want: "/interface/state/counters/in-octets"},
+		{desc: "unknown module", uri: "/restconf/data/no-such-module:interface/mtu", wantErr: true},
+		{desc: "wrong module", uri: "/restconf/data/network-device-extensions:interface/mtu", wantErr: true},
+		{desc: "wrong module for an augmented leaf", uri: "/restconf/data/network-device:interface/network-device:status", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			p, err := FromRESTCONFPath(tt.uri)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("FromRESTCONFPath() = %v, want an error", p)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FromRESTCONFPath() error = %v", err)
+			}
+			got, err := ygot.PathToString(p)
+			if err != nil {
+				t.Fatalf("PathToString() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("FromRESTCONFPath() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRESTCONFRoundTrip(t *testing.T) {
+	payload := []byte(`{
+  "network-device:interface": {
+    "mtu": 1500,
+    "name": "eth0",
+    "network-device-extensions:bandwidth": 1000,
+    "network-device-extensions:status": "up",
+    "priority": 12
+  }
+}`)
+	var d Device
+	if err := UnmarshalRESTCONF(payload, &d); err != nil {
+		t.Fatalf("UnmarshalRESTCONF() error = %v", err)
+	}
+	if n, _ := ygot.Diff(augmentDevice(), &d); len(n.GetUpdate())+len(n.GetDelete()) != 0 {
+		t.Errorf("UnmarshalRESTCONF() differs from the augment device: %v", n)
+	}
+
+	out, err := EmitRESTCONF(&d)
+	if err != nil {
+		t.Fatalf("EmitRESTCONF() error = %v", err)
+	}
+	if string(out) != string(payload) {
+		t.Errorf("EmitRESTCONF() = %s, want %s", out, payload)
+	}
+}
+
+func TestRESTCONFPatch(t *testing.T) {
+	d := augmentDevice()
+	body := []byte(`{"network-device:interface": {"mtu": 9000, "priority": null}}`)
+	if err := RESTCONFPatch(d, "/restconf/data/network-device:interface", body); err != nil {
+		t.Fatalf("RESTCONFPatch() error = %v", err)
+	}
+	if got := d.Interface.GetMtu(); got != 9000 {
+		t.Errorf("mtu = %d, want 9000", got)
+	}
+	if d.Interface.Priority != nil {
+		t.Errorf("priority = %d, want it cleared", *d.Interface.Priority)
+	}
+	if got := d.Interface.GetName(); got != "eth0" {
+		t.Errorf("name = %q, want eth0 left as it was", got)
+	}
+
+	body = []byte(`{"tag": [{"key": "env", "value": "prod"}]}`)
+	if err := RESTCONFPatch(d, "/restconf/data/network-device:interface/tags/tag=env", body); err != nil {
+		t.Fatalf("RESTCONFPatch(tag) error = %v", err)
+	}
+	if got, _ := d.Interface.GetTag("env"); got != "prod" {
+		t.Errorf("tag env = %q, want prod", got)
+	}
+
+	for _, tt := range []struct {
+		desc string
+		path string
+		body string
+	}{
+		{"other node", "/restconf/data/network-device:interface", `{"network-device:system": {}}`},
+		{"invalid JSON", "/restconf/data/network-device:interface", `{"interface": `},
+		{"unknown path", "/restconf/data/network-device:no-such-node", `{"no-such-node": {}}`},
+	} {
+		if err := RESTCONFPatch(d, tt.path, []byte(tt.body)); err == nil {
+			t.Errorf("RESTCONFPatch(%s) error = nil, want an error", tt.desc)
+		}
+	}
+
+	// An invalid result leaves the device as it was.
+	body = []byte(`{"interface": {"priority": 7}}`)
+	if err := RESTCONFPatch(d, "/restconf/data/interface", body); !errors.Is(err, ErrValidation) {
+		t.Errorf("RESTCONFPatch(priority 7) error = %v, want ErrValidation", err)
+	}
+	if d.Interface.Priority != nil || d.Interface.GetMtu() != 9000 {
+		t.Errorf("interface after a failed patch = %+v, want it unchanged", d.Interface)
+	}
+}
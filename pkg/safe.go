@@ -0,0 +1,55 @@
+package network
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+// SafeDevice wraps a Device so that it can be shared between goroutines.
+// Reads run concurrently, while writes are serialized and only take effect if
+// the resulting device is valid.
+type SafeDevice struct {
+	mu sync.RWMutex
+	d  *Device
+}
+
+// NewSafeDevice returns a SafeDevice holding d. The caller must not use d
+// directly afterwards.
+func NewSafeDevice(d *Device) *SafeDevice {
+	if d == nil {
+		d = &Device{}
+	}
+	return &SafeDevice{d: d}
+}
+
+// Read calls fn with the current device. fn must not modify the device or
+// keep a reference to it after returning.
+func (s *SafeDevice) Read(fn func(*Device)) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	fn(s.d)
+}
+
+// Write calls fn with a deep copy of the current device and, if fn succeeds
+// and the result is valid, atomically replaces the device with it. Otherwise
+// the device is unchanged and the error is returned.
+func (s *SafeDevice) Write(fn func(*Device) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cp, err := ygot.DeepCopy(s.d)
+	if err != nil {
+		return fmt.Errorf("cannot copy device: %w", err)
+	}
+	candidate := cp.(*Device)
+	if err := fn(candidate); err != nil {
+		return err
+	}
+	if err := ValidateDevice(candidate); err != nil {
+		return err
+	}
+	s.d = candidate
+	return nil
+}
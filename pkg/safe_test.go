@@ -0,0 +1,81 @@
+package network
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+func TestSafeDeviceConcurrent(t *testing.T) {
+	d := &Device{}
+	iface := d.GetOrCreateInterface()
+	iface.Mtu = ygot.Uint16(1000)
+	iface.Priority = ygot.Uint8(1)
+	s := NewSafeDevice(d)
+
+	const writers, readers, writes = 4, 4, 50
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < writes; i++ {
+				err := s.Write(func(d *Device) error {
+					// Both leaves move together, readers must never see
+					// them apart.
+					d.Interface.Mtu = ygot.Uint16(d.Interface.GetMtu() + 1)
+					d.Interface.Priority = ygot.Uint8(d.Interface.GetPriority()%5 + 1)
+					return nil
+				})
+				if err != nil {
+					t.Errorf("Write() error = %v", err)
+				}
+			}
+		}()
+	}
+	for r := 0; r < readers; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < writes; i++ {
+				s.Read(func(d *Device) {
+					mtu, prio := d.Interface.GetMtu(), d.Interface.GetPriority()
+					if want := uint8((mtu-1000)%5) + 1; prio != want {
+						t.Errorf("Read() saw mtu %d with priority %d, want %d", mtu, prio, want)
+					}
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	s.Read(func(d *Device) {
+		if got, want := d.Interface.GetMtu(), uint16(1000+writers*writes); got != want {
+			t.Errorf("mtu = %d, want %d", got, want)
+		}
+	})
+}
+
+func TestSafeDeviceWriteRollback(t *testing.T) {
+	s := NewSafeDevice(augmentDevice())
+
+	if err := s.Write(func(d *Device) error {
+		d.Interface.Priority = ygot.Uint8(7)
+		return nil
+	}); !errors.Is(err, ErrValidation) {
+		t.Errorf("Write(invalid priority) error = %v, want ErrValidation", err)
+	}
+	if err := s.Write(func(d *Device) error {
+		d.Interface.Mtu = ygot.Uint16(9000)
+		return errUnitTest
+	}); !errors.Is(err, errUnitTest) {
+		t.Errorf("Write() error = %v, want %v", err, errUnitTest)
+	}
+	s.Read(func(d *Device) {
+		if d.Interface.GetPriority() != 12 || d.Interface.GetMtu() != 1500 {
+			t.Errorf("device = priority %d, mtu %d after failed writes, want 12, 1500", d.Interface.GetPriority(), d.Interface.GetMtu())
+		}
+	})
+}
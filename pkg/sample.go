@@ -0,0 +1,180 @@
+package network
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp/syntax"
+	"sort"
+	"strings"
+
+	"github.com/openconfig/goyang/pkg/yang"
+)
+
+// GenerateSample returns a device with every leaf populated with a value that
+// satisfies its schema constraints: the lower bound of integer ranges, an
+// enum value, and strings built from the leaf patterns. Only the first
+// case of each choice is populated, and speed is left out, since the sample
+// turns auto-negotiate on. The result is validated, including
+// ValidateConstraints.
+func GenerateSample() (*Device, error) {
+	sample, err := sampleContainer(SchemaTree["Device"])
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(sample)
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode sample: %w", err)
+	}
+
+	d := &Device{}
+	if err := Unmarshal(data, d); err != nil {
+		return nil, fmt.Errorf("cannot load sample: %w", err)
+	}
+	// ValidateConstraints only accepts a speed without auto-negotiation.
+	if i := d.GetInterface(); i != nil && i.GetAutoNegotiate() {
+		i.Speed = NetworkDevice_Interface_Speed_UNSET
+	}
+	if err := ValidateDevice(d); err != nil {
+		return nil, fmt.Errorf("generated sample: %w", err)
+	}
+	return d, nil
+}
+
+func sampleContainer(e *yang.Entry) (map[string]interface{}, error) {
+	names := make([]string, 0, len(e.Dir))
+	for name := range e.Dir {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	out := map[string]interface{}{}
+	for _, name := range names {
+		child := e.Dir[name]
+		switch {
+		case child.IsChoice():
+			if len(child.Dir) == 0 {
+				continue
+			}
+			cases := make([]string, 0, len(child.Dir))
+			for c := range child.Dir {
+				cases = append(cases, c)
+			}
+			sort.Strings(cases)
+			v, err := sampleContainer(child.Dir[cases[0]])
+			if err != nil {
+				return nil, err
+			}
+			for k, cv := range v {
+				out[k] = cv
+			}
+		case child.IsList():
+			v, err := sampleContainer(child)
+			if err != nil {
+				return nil, err
+			}
+			out[name] = []interface{}{v}
+		case child.IsDir():
+			v, err := sampleContainer(child)
+			if err != nil {
+				return nil, err
+			}
+			out[name] = v
+		case child.IsLeaf(), child.IsLeafList():
+			v, err := sampleValue(child.Type)
+			if err != nil {
+				return nil, fmt.Errorf("cannot generate %s: %w", child.Path(), err)
+			}
+			if v == nil {
+				continue
+			}
+			if child.IsLeafList() {
+				v = []interface{}{v}
+			}
+			out[name] = v
+		}
+	}
+	return out, nil
+}
+
+// sampleValue returns a JSON value of type t, or nil for types that cannot be
+// sampled on their own, such as leafrefs.
+func sampleValue(t *yang.YangType) (interface{}, error) {
+	switch t.Kind {
+	case yang.Ystring:
+		if len(t.Pattern) == 0 {
+			return "sample", nil
+		}
+		return patternSample(t.Pattern[0])
+	case yang.Ybool:
+		return true, nil
+	case yang.Yint8, yang.Yint16, yang.Yint32, yang.Yuint8, yang.Yuint16, yang.Yuint32:
+		if len(t.Range) == 0 {
+			return json.Number("0"), nil
+		}
+		return json.Number(t.Range[0].Min.String()), nil
+	case yang.Yint64, yang.Yuint64, yang.Ydecimal64:
+		// RFC 7951 encodes these types as strings.
+		if len(t.Range) == 0 {
+			return "0", nil
+		}
+		return t.Range[0].Min.String(), nil
+	case yang.Yenum:
+		if names := t.Enum.Names(); len(names) > 0 {
+			return names[0], nil
+		}
+	case yang.Yidentityref:
+		if t.IdentityBase != nil && len(t.IdentityBase.Values) > 0 {
+			return t.IdentityBase.Values[0].Name, nil
+		}
+	case yang.Ybinary:
+		return base64.StdEncoding.EncodeToString([]byte("sample")), nil
+	case yang.Yempty:
+		return []interface{}{nil}, nil
+	case yang.Yunion:
+		for _, ut := range t.Type {
+			if v, err := sampleValue(ut); err == nil && v != nil {
+				return v, nil
+			}
+		}
+		return nil, fmt.Errorf("no member of union %s can be sampled", t.Name)
+	}
+	return nil, nil
+}
+
+// patternSample returns the shortest string matching the regular expression
+// pattern, taking the first alternative wherever there is a choice.
+func patternSample(pattern string) (string, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", fmt.Errorf("cannot parse pattern %q: %w", pattern, err)
+	}
+	var b strings.Builder
+	writeSample(&b, re.Simplify())
+	return b.String(), nil
+}
+
+func writeSample(b *strings.Builder, re *syntax.Regexp) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		b.WriteString(string(re.Rune))
+	case syntax.OpCharClass:
+		if len(re.Rune) > 0 {
+			b.WriteRune(re.Rune[0])
+		}
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		b.WriteByte('a')
+	case syntax.OpCapture, syntax.OpPlus:
+		writeSample(b, re.Sub[0])
+	case syntax.OpRepeat:
+		for i := 0; i < re.Min; i++ {
+			writeSample(b, re.Sub[0])
+		}
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			writeSample(b, sub)
+		}
+	case syntax.OpAlternate:
+		writeSample(b, re.Sub[0])
+	}
+}
@@ -0,0 +1,17 @@
+package network
+
+import "testing"
+
+func TestGenerateSample(t *testing.T) {
+	d, err := GenerateSample()
+	if err != nil {
+		t.Fatalf("GenerateSample() error = %v", err)
+	}
+	if err := ValidateDevice(d); err != nil {
+		t.Errorf("ValidateDevice() of the sample error = %v", err)
+	}
+	iface := d.GetInterface()
+	if iface.GetName() == "" || iface.Priority == nil || iface.Mtu == nil {
+		t.Errorf("sample interface = name %q, priority %v, mtu %v, want all of them set", iface.GetName(), iface.Priority, iface.Mtu)
+	}
+}
@@ -0,0 +1,272 @@
+package network
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/openconfig/goyang/pkg/yang"
+)
+
+// DeprecatedLeaves returns the sorted paths of the leaves and leaf-lists
+// marked "status deprecated" in the schema.
+func DeprecatedLeaves() []string {
+	var out []string
+	walkSchema(SchemaTree["Device"], "", func(path string, e *yang.Entry) {
+		if e.IsLeaf() || e.IsLeafList() {
+			if entryStatus(e) == "deprecated" {
+				out = append(out, path)
+			}
+		}
+	})
+	sort.Strings(out)
+	return out
+}
+
+// walkSchema calls fn for every data node below e, with its path. Choice and
+// case statements are looked through, since they do not appear in data paths.
+func walkSchema(e *yang.Entry, path string, fn func(path string, e *yang.Entry)) {
+	names := make([]string, 0, len(e.Dir))
+	for name := range e.Dir {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		child := e.Dir[name]
+		if child.IsChoice() || child.IsCase() {
+			walkSchema(child, path, fn)
+			continue
+		}
+		p := path + "/" + name
+		fn(p, child)
+		walkSchema(child, p, fn)
+	}
+}
+
+// inList reports whether e is below a list, so that its data paths need the
+// keys of a member.
+func inList(e *yang.Entry) bool {
+	for p := e.Parent; p != nil; p = p.Parent {
+		if p.IsList() {
+			return true
+		}
+	}
+	return false
+}
+
+// entryStatus returns the YANG status of e: "current", "deprecated" or
+// "obsolete". goyang keeps the status statement in the Extra field of the
+// entry.
+func entryStatus(e *yang.Entry) string {
+	for _, s := range e.Extra["status"] {
+		switch v := s.(type) {
+		case *yang.Value:
+			return v.Name
+		case map[string]interface{}:
+			if name, ok := v["Name"].(string); ok {
+				return name
+			}
+		}
+	}
+	return "current"
+}
+
+// LeafUnits returns the units of the leaf at path, e.g. "bytes" for
+// /interface/mtu, or an empty string if the leaf has no units statement.
+func LeafUnits(path string) (string, error) {
+	_, e, err := resolvePath(path)
+	if err != nil {
+		return "", err
+	}
+	if !e.IsLeaf() && !e.IsLeafList() {
+		return "", fmt.Errorf("%s is not a leaf", path)
+	}
+	return entryUnits(e), nil
+}
+
+// entryUnits returns the units of the leaf or leaf-list e. goyang only keeps
+// units declared on a typedef, so leaves that need them take their type from
+// one.
+func entryUnits(e *yang.Entry) string {
+	if e.Units != "" {
+		return e.Units
+	}
+	return e.Type.Units
+}
+
+// LeafDescriptions returns the description statements of the leaves and
+// leaf-lists of the schema, keyed by path, e.g. /interface/mtu, including
+// those added by augmentations. Leaves without a description are left out.
+// The schema only holds descriptions because generate.sh passes
+// -include_descriptions to the generator.
+func LeafDescriptions() map[string]string {
+	out := map[string]string{}
+	walkSchema(SchemaTree["Device"], "", func(path string, e *yang.Entry) {
+		if (e.IsLeaf() || e.IsLeafList()) && e.Description != "" {
+			out[path] = e.Description
+		}
+	})
+	return out
+}
+
+// CompletionsAt returns the sorted names that may follow path, e.g. /interface,
+// for a CLI to offer as completions: the child nodes of a container or list,
+// looking through choice and case statements, or the enumerated values of a
+// leaf. Leaves without enumerated values have no completions.
+func CompletionsAt(path string) ([]string, error) {
+	_, e, err := resolvePath(path)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	if e.IsDir() {
+		names = dataChildren(e)
+	} else {
+		names = enumNames(e.Type)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// dataChildren returns the names of the data nodes right below e, looking
+// through choice and case statements like childEntry.
+func dataChildren(e *yang.Entry) []string {
+	var names []string
+	for name, child := range e.Dir {
+		if child.IsChoice() || child.IsCase() {
+			names = append(names, dataChildren(child)...)
+			continue
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// DumpSchema writes the schema tree loaded by ygot to w, one node per line
+// indented by depth, with its kind, its type and the restrictions of the type.
+// It is meant for debugging:
+//
+//	interface container
+//	  mtu leaf mtu-size (uint16) range=68..65535 units=bytes
+func DumpSchema(w io.Writer) {
+	dumpEntry(w, SchemaTree["Device"], 0)
+}
+
+// SchemaHash returns a hex-encoded SHA-256 hash of the schema as printed by
+// DumpSchema: node names, kinds, types and their restrictions. Since nodes are
+// listed in name order, the hash only changes when the schema does, not when
+// statements are reordered in the YANG files.
+func SchemaHash() string {
+	h := sha256.New()
+	DumpSchema(h)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// The compiled schema does not keep the metadata of the modules it was built
+// from, so the organization and the date of the latest revision statement of
+// the network-device module are recorded here. A test checks them against
+// base.yang.
+const (
+	moduleOrganization = "go-yang-basics"
+	moduleRevision     = "2026-10-16"
+)
+
+// ModuleRevision returns the revision date of the network-device module the
+// package was generated from, e.g. "2026-10-16", for clients to report which
+// version of the schema they use.
+func ModuleRevision() string {
+	return moduleRevision
+}
+
+func dumpEntry(w io.Writer, e *yang.Entry, depth int) {
+	names := make([]string, 0, len(e.Dir))
+	for name := range e.Dir {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		child := e.Dir[name]
+		fmt.Fprintf(w, "%s%s %s%s\n", strings.Repeat("  ", depth), name, entryKind(child), typeString(child))
+		dumpEntry(w, child, depth+1)
+	}
+}
+
+// entryKind returns the YANG statement that defines e.
+func entryKind(e *yang.Entry) string {
+	switch {
+	case e.IsChoice():
+		return "choice"
+	case e.IsCase():
+		return "case"
+	case e.IsList():
+		return "list"
+	case e.IsContainer():
+		return "container"
+	case e.IsLeafList():
+		return "leaf-list"
+	}
+	return "leaf"
+}
+
+// typeString describes the type of a leaf or leaf-list entry, starting with a
+// space, or returns an empty string for any other entry.
+func typeString(e *yang.Entry) string {
+	if e.Type == nil || (!e.IsLeaf() && !e.IsLeafList()) {
+		return ""
+	}
+	s := " " + describeType(e.Type)
+	if units := entryUnits(e); units != "" {
+		s += " units=" + units
+	}
+	return s
+}
+
+func describeType(t *yang.YangType) string {
+	var b strings.Builder
+	b.WriteString(t.Name)
+	if t.Name != t.Kind.String() {
+		fmt.Fprintf(&b, " (%s)", t.Kind)
+	}
+	if base, ok := yang.BaseTypedefs[t.Kind.String()]; len(t.Range) > 0 && (!ok || !t.Range.Equal(base.YangType.Range)) {
+		fmt.Fprintf(&b, " range=%s", t.Range)
+	}
+	if len(t.Length) > 0 {
+		fmt.Fprintf(&b, " length=%s", t.Length)
+	}
+	for _, p := range t.Pattern {
+		fmt.Fprintf(&b, " pattern=%s", p)
+	}
+	if t.Enum != nil {
+		fmt.Fprintf(&b, " enum=%s", strings.Join(t.Enum.Names(), "|"))
+	}
+	if t.IdentityBase != nil {
+		fmt.Fprintf(&b, " base=%s", t.IdentityBase.Name)
+	}
+	if len(t.Type) > 0 {
+		members := make([]string, 0, len(t.Type))
+		for _, m := range t.Type {
+			members = append(members, describeType(m))
+		}
+		fmt.Fprintf(&b, " {%s}", strings.Join(members, "; "))
+	}
+	return b.String()
+}
+
+// ValidPriorities returns, in ascending order, every value allowed by the
+// range of the priority leaf.
+func ValidPriorities() []uint8 {
+	var out []uint8
+	for _, r := range childEntry(SchemaTree["NetworkDevice_Interface"], "priority").Type.Range {
+		lo, _ := r.Min.Int()
+		hi, _ := r.Max.Int()
+		for v := lo; v <= hi; v++ {
+			out = append(out, uint8(v))
+		}
+	}
+	return out
+}
@@ -0,0 +1,219 @@
+package network
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ygot"
+)
+
+func TestDeprecatedLeaves(t *testing.T) {
+	want := []string{"/interface/duplex"}
+	if got := DeprecatedLeaves(); !reflect.DeepEqual(got, want) {
+		t.Errorf("DeprecatedLeaves() = %v, want %v", got, want)
+	}
+}
+
+func TestLeafUnits(t *testing.T) {
+	tests := []struct {
+		path    string
+		want    string
+		wantErr bool
+	}{
+		{path: "/interface/mtu", want: "bytes"},
+		{path: "/interface/bandwidth", want: "Mbps"},
+		{path: "/interface/priority", want: ""},
+		{path: "/interface", wantErr: true},
+		{path: "/interface/speed-mbps", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := LeafUnits(tt.path)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("LeafUnits(%s) = %q, %v, want %q, error %v", tt.path, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestMtuRange(t *testing.T) {
+	for _, tt := range []struct {
+		mtu     uint16
+		wantErr bool
+	}{{10, true}, {67, true}, {68, false}, {1500, false}} {
+		d := &Device{}
+		d.GetOrCreateInterface().Mtu = ygot.Uint16(tt.mtu)
+		if err := ValidateDevice(d); (err != nil) != tt.wantErr || (err != nil && !errors.Is(err, ErrValidation)) {
+			t.Errorf("ValidateDevice(mtu %d) error = %v, want error %v", tt.mtu, err, tt.wantErr)
+		}
+	}
+}
+
+func TestDumpSchema(t *testing.T) {
+	var buf bytes.Buffer
+	DumpSchema(&buf)
+	out := buf.String()
+	for _, want := range []string{
+		"interface container\n",
+		"  name leaf string pattern=eth[0-9]+|wlan[0-9]+\n",
+		"  priority leaf priority-level (uint8) range=1..5|10..15\n",
+		"  mtu leaf mtu-size (uint16) range=68..65535 units=bytes\n",
+		"  status leaf union {enumeration enum=dormant|down|testing|up; string pattern=maintenance-.*}\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("DumpSchema() = %s, want it to contain %q", out, want)
+		}
+	}
+}
+
+func TestSchemaHash(t *testing.T) {
+	want := SchemaHash()
+	if len(want) != 64 {
+		t.Fatalf("SchemaHash() = %q, want a hex SHA-256", want)
+	}
+
+	loaded := SchemaTree
+	t.Cleanup(func() { SchemaTree = loaded })
+	reloaded, err := UnzipSchema()
+	if err != nil {
+		t.Fatalf("UnzipSchema() error = %v", err)
+	}
+	SchemaTree = reloaded
+	if got := SchemaHash(); got != want {
+		t.Errorf("SchemaHash() after reloading = %s, want %s", got, want)
+	}
+
+	mtu := reloaded["Device"].Dir["interface"].Dir["mtu"]
+	mtu.Type.Range = yang.YangRange{{Min: yang.FromInt(68), Max: yang.FromInt(9216)}}
+	if got := SchemaHash(); got == want {
+		t.Errorf("SchemaHash() after changing the mtu range = %s, want a different hash", got)
+	}
+}
+
+// readBaseModule reads the network-device module from base.yang, failing t
+// if it has no revision.
+func readBaseModule(t *testing.T) *yang.Module {
+	t.Helper()
+	ms := yang.NewModules()
+	if err := ms.Read("../base.yang"); err != nil {
+		t.Fatalf("Read(base.yang) error = %v", err)
+	}
+	m, ok := ms.Modules["network-device"]
+	if !ok || len(m.Revision) == 0 {
+		t.Fatal("base.yang has no network-device module with a revision")
+	}
+	return m
+}
+
+func TestModuleRevision(t *testing.T) {
+	// RFC 7950 lists the revisions of a module newest first.
+	if got, want := ModuleRevision(), readBaseModule(t).Revision[0].Name; got != want {
+		t.Errorf("ModuleRevision() = %q, want %q from base.yang", got, want)
+	}
+}
+
+func TestValidPriorities(t *testing.T) {
+	want := []uint8{1, 2, 3, 4, 5, 10, 11, 12, 13, 14, 15}
+	got := ValidPriorities()
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ValidPriorities() = %v, want %v", got, want)
+	}
+	for _, p := range got {
+		i := &NetworkDevice_Interface{Priority: ygot.Uint8(p)}
+		if err := i.Validate(); err != nil {
+			t.Errorf("Validate(priority %d) error = %v, want nil", p, err)
+		}
+	}
+
+	loaded := SchemaTree
+	t.Cleanup(func() { SchemaTree = loaded })
+	reloaded, err := UnzipSchema()
+	if err != nil {
+		t.Fatalf("UnzipSchema() error = %v", err)
+	}
+	SchemaTree = reloaded
+	priority := reloaded["NetworkDevice_Interface"].Dir["priority"]
+	priority.Type.Range = yang.YangRange{{Min: yang.FromInt(2), Max: yang.FromInt(3)}}
+	if got, want := ValidPriorities(), []uint8{2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidPriorities() with range 2..3 = %v, want %v", got, want)
+	}
+}
+
+func TestLeafDescriptions(t *testing.T) {
+	var want string
+	for _, c := range readBaseModule(t).Container {
+		for _, l := range c.Leaf {
+			if c.Name == "interface" && l.Name == "mtu" && l.Description != nil {
+				want = l.Description.Name
+			}
+		}
+	}
+	if want == "" {
+		t.Fatal("base.yang has no description for /interface/mtu")
+	}
+
+	got := LeafDescriptions()
+	if got["/interface/mtu"] != want {
+		t.Errorf("LeafDescriptions()[/interface/mtu] = %q, want %q", got["/interface/mtu"], want)
+	}
+	for path, want := range map[string]string{
+		"/interface/status":    "Interface operational status",
+		"/interface/bandwidth": "Interface bandwidth in Megabits per second",
+	} {
+		if got[path] != want {
+			t.Errorf("LeafDescriptions()[%s] = %q, want %q", path, got[path], want)
+		}
+	}
+	if _, ok := got["/interface"]; ok {
+		t.Error("LeafDescriptions() includes the /interface container")
+	}
+}
+
+func TestCompletionsAt(t *testing.T) {
+	for _, tt := range []struct {
+		path    string
+		want    []string
+		wantErr bool
+	}{
+		{path: "/", want: []string{"interface", "system"}},
+		{path: "/interface/state", want: []string{"counters", "jumbo-mtu"}},
+		{path: "/interface/status", want: []string{"dormant", "down", "testing", "up"}},
+		{path: "/interface/duplex", want: []string{"full", "half"}},
+		{path: "/interface/mtu", want: nil},
+		{path: "/interface/bogus", wantErr: true},
+	} {
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := CompletionsAt(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CompletionsAt(%s) error = %v, wantErr %v", tt.path, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CompletionsAt(%s) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+
+	got, err := CompletionsAt("/interface")
+	if err != nil {
+		t.Fatalf("CompletionsAt(/interface) error = %v", err)
+	}
+	has := map[string]bool{}
+	for _, name := range got {
+		has[name] = true
+	}
+	// ipv4-address and dhcp are within the address-mode choice.
+	for _, name := range []string{"name", "mtu", "priority", "status", "bandwidth", "ipv4-address", "dhcp", "state"} {
+		if !has[name] {
+			t.Errorf("CompletionsAt(/interface) = %q, want it to include %s", got, name)
+		}
+	}
+	if has["address-mode"] {
+		t.Errorf("CompletionsAt(/interface) = %q, want no choice names", got)
+	}
+	if !sort.StringsAreSorted(got) {
+		t.Errorf("CompletionsAt(/interface) = %q, want it sorted", got)
+	}
+}
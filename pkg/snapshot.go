@@ -0,0 +1,38 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+// Snapshot is a point-in-time copy of a device. Its Device can be compared
+// with other devices using ygot.Diff or DiffText.
+type Snapshot struct {
+	// Time is when the snapshot was taken.
+	Time time.Time
+	// Device is a deep copy of the device at Time.
+	Device *Device
+}
+
+// TakeSnapshot returns a deep copy of d stamped with the current time. It is
+// not called Snapshot, as that is the name of the type it returns.
+func TakeSnapshot(d *Device) (*Snapshot, error) {
+	cp, err := ygot.DeepCopy(d)
+	if err != nil {
+		return nil, fmt.Errorf("cannot copy device: %w", err)
+	}
+	return &Snapshot{Time: time.Now(), Device: cp.(*Device)}, nil
+}
+
+// Restore overwrites d with the contents of the snapshot. The snapshot is
+// left unchanged, so it can be restored again.
+func Restore(d *Device, s *Snapshot) error {
+	cp, err := ygot.DeepCopy(s.Device)
+	if err != nil {
+		return fmt.Errorf("cannot copy snapshot taken at %s: %w", s.Time.Format(time.RFC3339), err)
+	}
+	*d = *cp.(*Device)
+	return nil
+}
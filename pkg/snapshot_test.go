@@ -0,0 +1,40 @@
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	d := augmentDevice()
+	before := time.Now()
+	s, err := TakeSnapshot(d)
+	if err != nil {
+		t.Fatalf("TakeSnapshot() error = %v", err)
+	}
+	if s.Time.Before(before) {
+		t.Errorf("snapshot Time = %v, want after %v", s.Time, before)
+	}
+
+	d.Interface.Mtu = ygot.Uint16(9000)
+	d.Interface.ClearName()
+	if diff, _ := DiffText(s.Device, d); len(diff) != 2 {
+		t.Errorf("DiffText(snapshot, mutated) = %q, want 2 differences", diff)
+	}
+	if got := s.Device.GetInterface().GetMtu(); got != 1500 {
+		t.Errorf("snapshot mtu = %d after mutating the device, want 1500", got)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := Restore(d, s); err != nil {
+			t.Fatalf("Restore() error = %v", err)
+		}
+		if diff, _ := DiffText(s.Device, d); len(diff) != 0 {
+			t.Errorf("DiffText(snapshot, restored) = %q, want no differences", diff)
+		}
+		// The restored device must not share memory with the snapshot.
+		d.Interface.Mtu = ygot.Uint16(68)
+	}
+}
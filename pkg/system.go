@@ -0,0 +1,38 @@
+package network
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+// AppendDnsServer adds server to the end of the DNS server list. The
+// dns-server leaf-list is "ordered-by user", so its order is kept as is
+// when the device is emitted.
+func (t *NetworkDevice_System) AppendDnsServer(server string) {
+	t.DnsServer = append(t.DnsServer, server)
+}
+
+// InsertDnsServerAt inserts server at position i of the DNS server list,
+// shifting the following entries. It returns an error if i is out of range.
+func (t *NetworkDevice_System) InsertDnsServerAt(i int, server string) error {
+	if i < 0 || i > len(t.DnsServer) {
+		return fmt.Errorf("index %d out of range for %d DNS servers", i, len(t.DnsServer))
+	}
+	t.DnsServer = slices.Insert(t.DnsServer, i, server)
+	return nil
+}
+
+// ApplyDefaultMtu sets the mtu of the interface of d, if it has none, to the
+// default-mtu of the system container. Interfaces that set their own mtu keep
+// it, and nothing changes if no default is set.
+func ApplyDefaultMtu(d *Device) {
+	s := d.GetSystem()
+	if s == nil || s.DefaultMtu == nil {
+		return
+	}
+	if i := d.GetInterface(); i != nil && i.Mtu == nil {
+		i.Mtu = ygot.Uint16(*s.DefaultMtu)
+	}
+}
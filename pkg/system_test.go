@@ -0,0 +1,80 @@
+package network
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+func TestDnsServerOrder(t *testing.T) {
+	d := &Device{}
+	sys := d.GetOrCreateSystem()
+	sys.AppendDnsServer("10.0.0.3")
+	sys.AppendDnsServer("10.0.0.1")
+	if err := sys.InsertDnsServerAt(1, "10.0.0.2"); err != nil {
+		t.Fatalf("InsertDnsServerAt() error = %v", err)
+	}
+	if err := sys.InsertDnsServerAt(4, "10.0.0.4"); err == nil {
+		t.Error("InsertDnsServerAt(4) error = nil, want out of range")
+	}
+
+	out, err := ygot.EmitJSON(d, nil)
+	if err != nil {
+		t.Fatalf("EmitJSON() error = %v", err)
+	}
+	var got struct {
+		System struct {
+			DnsServer []string `json:"dns-server"`
+		} `json:"system"`
+	}
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("cannot decode %s: %v", out, err)
+	}
+	if want := []string{"10.0.0.3", "10.0.0.2", "10.0.0.1"}; !reflect.DeepEqual(got.System.DnsServer, want) {
+		t.Errorf("dns-server = %v, want insertion order %v", got.System.DnsServer, want)
+	}
+}
+
+func TestApplyDefaultMtu(t *testing.T) {
+	for _, tt := range []struct {
+		desc       string
+		defaultMtu *uint16
+		mtu        *uint16
+		want       uint16 // 0 for unset
+	}{
+		{"unset mtu", ygot.Uint16(9000), nil, 9000},
+		{"explicit mtu", ygot.Uint16(9000), ygot.Uint16(1500), 1500},
+		{"no default", nil, nil, 0},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			d := augmentDevice()
+			d.Interface.Mtu = tt.mtu
+			d.GetOrCreateSystem().DefaultMtu = tt.defaultMtu
+			ApplyDefaultMtu(d)
+			if got := d.Interface.GetMtu(); got != tt.want {
+				t.Errorf("ApplyDefaultMtu() mtu = %d, want %d", got, tt.want)
+			}
+			if err := ValidateDevice(d); err != nil {
+				t.Errorf("ValidateDevice() error = %v", err)
+			}
+		})
+	}
+
+	// A device without an interface is left alone.
+	d := &Device{System: &NetworkDevice_System{DefaultMtu: ygot.Uint16(9000)}}
+	ApplyDefaultMtu(d)
+	if d.Interface != nil {
+		t.Errorf("ApplyDefaultMtu() created interface %+v, want none", d.Interface)
+	}
+
+	// Nor is a device without a system container, or a nil device.
+	d = augmentDevice()
+	d.Interface.Mtu = nil
+	ApplyDefaultMtu(d)
+	if d.Interface.Mtu != nil || d.System != nil {
+		t.Errorf("ApplyDefaultMtu() without a system container = mtu %v, system %+v, want both nil", d.Interface.Mtu, d.System)
+	}
+	ApplyDefaultMtu(nil)
+}
@@ -0,0 +1,30 @@
+package network
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+)
+
+// ExpandTemplate executes the text/template tmpl with data, which must render
+// RFC7951 JSON, and returns the device it describes. The device is validated,
+// including ValidateConstraints.
+func ExpandTemplate(tmpl string, data interface{}) (*Device, error) {
+	t, err := template.New("device").Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("cannot execute template: %w", err)
+	}
+
+	d := &Device{}
+	if err := Parse(buf.Bytes(), d); err != nil {
+		return nil, err
+	}
+	if err := ValidateDevice(d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
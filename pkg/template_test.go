@@ -0,0 +1,48 @@
+package network
+
+import (
+	"errors"
+	"testing"
+)
+
+const interfaceTemplate = `{"interface": {"name": "{{.Name}}", "mtu": {{.Mtu}}, "priority": {{.Priority}}}}`
+
+type interfaceData struct {
+	Name     string
+	Mtu      int
+	Priority int
+}
+
+func TestExpandTemplate(t *testing.T) {
+	d, err := ExpandTemplate(interfaceTemplate, interfaceData{Name: "eth3", Mtu: 9000, Priority: 12})
+	if err != nil {
+		t.Fatalf("ExpandTemplate() error = %v", err)
+	}
+	i := d.GetInterface()
+	if i.GetName() != "eth3" || i.GetMtu() != 9000 || i.GetPriority() != 12 {
+		t.Errorf("ExpandTemplate() = %q, %d, %d, want eth3, 9000, 12", i.GetName(), i.GetMtu(), i.GetPriority())
+	}
+}
+
+func TestExpandTemplateErrors(t *testing.T) {
+	for _, tt := range []struct {
+		desc    string
+		tmpl    string
+		data    interface{}
+		wantErr error
+	}{
+		{desc: "priority out of range", tmpl: interfaceTemplate, data: interfaceData{Name: "eth3", Mtu: 9000, Priority: 7}, wantErr: ErrValidation},
+		{desc: "bad template", tmpl: `{"interface": {{.Name}`},
+		{desc: "missing field", tmpl: `{"interface": {"name": "{{.Speed}}"}}`, data: interfaceData{}},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			d, err := ExpandTemplate(tt.tmpl, tt.data)
+			if err == nil {
+				t.Fatalf("ExpandTemplate() = %v, want an error", d)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("ExpandTemplate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package network
+
+import (
+	"fmt"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+// TransactSet applies fn to a deep copy of d and, if both fn and the
+// validation of the result succeed, replaces the contents of d with the
+// modified copy. Otherwise d is left untouched and the error is returned,
+// making the edit all-or-nothing.
+func TransactSet(d *Device, fn func(*Device) error) error {
+	cp, err := ygot.DeepCopy(d)
+	if err != nil {
+		return fmt.Errorf("cannot copy device: %w", err)
+	}
+	candidate := cp.(*Device)
+
+	if err := fn(candidate); err != nil {
+		return err
+	}
+	if err := ValidateDevice(candidate); err != nil {
+		return err
+	}
+	*d = *candidate
+	return nil
+}
@@ -0,0 +1,62 @@
+package network
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+func TestTransactSet(t *testing.T) {
+	tests := []struct {
+		desc     string
+		fn       func(*Device) error
+		wantErr  error
+		wantMtu  uint16
+		wantPrio uint8
+	}{{
+		desc:     "valid mtu is committed",
+		fn:       func(d *Device) error { d.Interface.Mtu = ygot.Uint16(9000); return nil },
+		wantMtu:  9000,
+		wantPrio: 12,
+	}, {
+		desc: "invalid priority is rolled back",
+		fn: func(d *Device) error {
+			d.Interface.Mtu = ygot.Uint16(9000)
+			d.Interface.Priority = ygot.Uint8(7)
+			return nil
+		},
+		wantErr:  ErrValidation,
+		wantMtu:  1500,
+		wantPrio: 12,
+	}, {
+		desc: "closure error is rolled back",
+		fn: func(d *Device) error {
+			d.Interface.Mtu = ygot.Uint16(9000)
+			return errUnitTest
+		},
+		wantErr:  errUnitTest,
+		wantMtu:  1500,
+		wantPrio: 12,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			d := &Device{}
+			iface := d.GetOrCreateInterface()
+			iface.Mtu = ygot.Uint16(1500)
+			iface.Priority = ygot.Uint8(12)
+
+			if err := TransactSet(d, tt.fn); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("TransactSet() error = %v, want %v", err, tt.wantErr)
+			}
+			if got := d.GetInterface().GetMtu(); got != tt.wantMtu {
+				t.Errorf("mtu = %d, want %d", got, tt.wantMtu)
+			}
+			if got := d.GetInterface().GetPriority(); got != tt.wantPrio {
+				t.Errorf("priority = %d, want %d", got, tt.wantPrio)
+			}
+		})
+	}
+}
+
+var errUnitTest = errors.New("unit test error")
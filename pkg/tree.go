@@ -0,0 +1,36 @@
+package network
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Tree renders the populated leaves of d as an indented tree, with one
+// container or leaf per line:
+//
+//	interface
+//	  mtu: 1500
+//	  name: eth0
+func Tree(d *Device) string {
+	var (
+		b    strings.Builder
+		open []string
+	)
+	Walk(d, func(path string, value interface{}) error {
+		elems := strings.Split(strings.TrimPrefix(path, "/"), "/")
+		dirs, leaf := elems[:len(elems)-1], elems[len(elems)-1]
+
+		// Skip the containers shared with the previous leaf.
+		n := 0
+		for n < len(open) && n < len(dirs) && open[n] == dirs[n] {
+			n++
+		}
+		for i := n; i < len(dirs); i++ {
+			fmt.Fprintf(&b, "%s%s\n", strings.Repeat("  ", i), dirs[i])
+		}
+		open = dirs
+		fmt.Fprintf(&b, "%s%s: %v\n", strings.Repeat("  ", len(dirs)), leaf, value)
+		return nil
+	})
+	return b.String()
+}
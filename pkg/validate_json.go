@@ -0,0 +1,212 @@
+package network
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ytypes"
+)
+
+// ValidateJSON checks that data, which must be RFC7951 JSON, conforms to the
+// device schema without unmarshaling it into a Device. Unknown nodes, values
+// of the wrong type, and values that break range, length or pattern
+// restrictions are all reported.
+func ValidateJSON(data []byte) error {
+	var tree interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&tree); err != nil {
+		return withPosition(data, err)
+	}
+	return validateJSONNode(SchemaTree["Device"], tree, "")
+}
+
+// validateJSONNode validates the JSON value v against the schema entry e,
+// found at path.
+func validateJSONNode(e *yang.Entry, v interface{}, path string) error {
+	switch {
+	case e.IsList():
+		members, ok := v.([]interface{})
+		if !ok {
+			return fmt.Errorf("%s: got %T, want a JSON array for list", path, v)
+		}
+		var errs []error
+		for i, m := range members {
+			errs = append(errs, validateJSONContainer(e, m, fmt.Sprintf("%s[%d]", path, i)))
+		}
+		return errors.Join(errs...)
+	case e.IsDir():
+		return validateJSONContainer(e, v, path)
+	case e.IsLeafList():
+		values, ok := v.([]interface{})
+		if !ok {
+			return fmt.Errorf("%s: got %T, want a JSON array for leaf-list", path, v)
+		}
+		var errs []error
+		for i, lv := range values {
+			if err := validateJSONLeaf(e.Type, lv); err != nil {
+				errs = append(errs, fmt.Errorf("%s[%d]: %w", path, i, err))
+			}
+		}
+		return errors.Join(errs...)
+	default:
+		if err := validateJSONLeaf(e.Type, v); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		return nil
+	}
+}
+
+func validateJSONContainer(e *yang.Entry, v interface{}, path string) error {
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("%s: got %T, want a JSON object", path, v)
+	}
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var errs []error
+	for _, k := range keys {
+		// RFC7951 member names may be qualified with their module name.
+		name := k
+		if i := strings.Index(k, ":"); i >= 0 {
+			name = k[i+1:]
+		}
+		child := childEntry(e, name)
+		if child == nil {
+			errs = append(errs, fmt.Errorf("%s/%s: %w", path, name, ErrUnknownField))
+			continue
+		}
+		errs = append(errs, validateJSONNode(child, obj[k], path+"/"+name))
+	}
+	return errors.Join(errs...)
+}
+
+// validateJSONLeaf validates a single leaf value against its YANG type.
+func validateJSONLeaf(t *yang.YangType, v interface{}) error {
+	switch t.Kind {
+	case yang.Yint8, yang.Yint16, yang.Yint32, yang.Yint64:
+		s, err := jsonNumber(v, t.Kind == yang.Yint64)
+		if err != nil {
+			return err
+		}
+		i, err := strconv.ParseInt(s, 10, intBits(t.Kind))
+		if err != nil {
+			return fmt.Errorf("invalid %v value %s", t.Kind, s)
+		}
+		return ytypes.ValidateIntRestrictions(t, i)
+	case yang.Yuint8, yang.Yuint16, yang.Yuint32, yang.Yuint64:
+		s, err := jsonNumber(v, t.Kind == yang.Yuint64)
+		if err != nil {
+			return err
+		}
+		u, err := strconv.ParseUint(s, 10, intBits(t.Kind))
+		if err != nil {
+			return fmt.Errorf("invalid %v value %s", t.Kind, s)
+		}
+		return ytypes.ValidateUintRestrictions(t, u)
+	case yang.Ydecimal64:
+		s, err := jsonNumber(v, true)
+		if err != nil {
+			return err
+		}
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("invalid decimal64 value %s", s)
+		}
+		return ytypes.ValidateDecimalRestrictions(t, f)
+	case yang.Ystring:
+		s, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("got %T, want string", v)
+		}
+		return ytypes.ValidateStringRestrictions(t, s)
+	case yang.Ybool:
+		if _, ok := v.(bool); !ok {
+			return fmt.Errorf("got %T, want boolean", v)
+		}
+		return nil
+	case yang.Yempty:
+		if a, ok := v.([]interface{}); !ok || len(a) != 1 || a[0] != nil {
+			return fmt.Errorf("got %v, want [null] for empty type", v)
+		}
+		return nil
+	case yang.Ybinary:
+		s, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("got %T, want base64 string", v)
+		}
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return fmt.Errorf("invalid base64 value: %w", err)
+		}
+		return ytypes.ValidateBinaryRestrictions(t, b)
+	case yang.Yenum:
+		s, ok := v.(string)
+		if !ok || !t.Enum.IsDefined(s) {
+			return fmt.Errorf("%v is not a valid value, want one of %v", v, t.Enum.Names())
+		}
+		return nil
+	case yang.Yidentityref:
+		s, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("got %T, want identity name", v)
+		}
+		if i := strings.Index(s, ":"); i >= 0 {
+			s = s[i+1:]
+		}
+		if t.IdentityBase == nil || !t.IdentityBase.IsDefined(s) {
+			return fmt.Errorf("%q is not a valid identity", s)
+		}
+		return nil
+	case yang.Yunion:
+		var errs []error
+		for _, ut := range t.Type {
+			err := validateJSONLeaf(ut, v)
+			if err == nil {
+				return nil
+			}
+			errs = append(errs, err)
+		}
+		return fmt.Errorf("%v does not match any union member: %w", v, errors.Join(errs...))
+	default:
+		// Leafrefs and other types are checked once the data is unmarshaled.
+		return nil
+	}
+}
+
+// jsonNumber returns the textual form of a JSON number. RFC7951 encodes
+// 64-bit and decimal numbers as strings, which is accepted when quoted is set.
+func jsonNumber(v interface{}, quoted bool) (string, error) {
+	switch n := v.(type) {
+	case json.Number:
+		return n.String(), nil
+	case string:
+		if quoted {
+			return n, nil
+		}
+	}
+	return "", fmt.Errorf("got %T, want number", v)
+}
+
+func intBits(k yang.TypeKind) int {
+	switch k {
+	case yang.Yint8, yang.Yuint8:
+		return 8
+	case yang.Yint16, yang.Yuint16:
+		return 16
+	case yang.Yint32, yang.Yuint32:
+		return 32
+	}
+	return 64
+}
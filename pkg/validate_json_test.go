@@ -0,0 +1,51 @@
+package network
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateJSON(t *testing.T) {
+	tests := []struct {
+		desc    string
+		in      string
+		wantErr string
+	}{{
+		desc: "valid",
+		in:   `{"interface": {"name": "eth0", "mtu": 1500, "priority": 12, "status": "up"}}`,
+	}, {
+		desc:    "priority out of range",
+		in:      `{"interface": {"priority": 7}}`,
+		wantErr: "/interface/priority",
+	}, {
+		desc:    "name not matching the pattern",
+		in:      `{"interface": {"name": "lo0"}}`,
+		wantErr: "/interface/name",
+	}, {
+		desc:    "wrong type",
+		in:      `{"interface": {"mtu": "1500"}}`,
+		wantErr: "/interface/mtu",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := ValidateJSON([]byte(tt.in))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateJSON() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateJSON() error = %v, want one about %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateJSONUnknownField(t *testing.T) {
+	err := ValidateJSON([]byte(`{"interface": {"speed-mbps": 100}}`))
+	if !errors.Is(err, ErrUnknownField) {
+		t.Errorf("ValidateJSON() error = %v, want ErrUnknownField", err)
+	}
+}
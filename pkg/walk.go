@@ -0,0 +1,136 @@
+package network
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+)
+
+// Walk calls fn for every populated leaf of d with its path, e.g.
+// /interface/mtu, and its Go value. Enumerated leaves are passed as their
+// generated enum type and unions as the concrete union member. Leaves are
+// visited in struct field order, and walking stops at the first error
+// returned by fn, which Walk returns. A nil device has no leaves to visit.
+func Walk(d *Device, fn func(path string, value interface{}) error) error {
+	return walkElems(d, func(elems []*gnmi.PathElem, value interface{}) error {
+		return fn(elemsString(elems), value)
+	})
+}
+
+// walkElems is Walk with the path of each leaf as gNMI path elements, so that
+// callers that need a gnmi.Path do not have to parse it back from a string.
+// The elements of a leaf share their parents with those of its siblings, so
+// fn must copy them before making any change.
+func walkElems(d *Device, fn func([]*gnmi.PathElem, interface{}) error) error {
+	if d == nil {
+		return nil
+	}
+	return walkStruct(reflect.ValueOf(d).Elem(), nil, fn)
+}
+
+func walkStruct(v reflect.Value, prefix []*gnmi.PathElem, fn func([]*gnmi.PathElem, interface{}) error) error {
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		tag, ok := t.Field(i).Tag.Lookup("path")
+		if !ok {
+			continue
+		}
+		p := prefix[:len(prefix):len(prefix)]
+		for _, name := range strings.Split(tag, "/") {
+			p = append(p, &gnmi.PathElem{Name: name})
+		}
+		f := v.Field(i)
+
+		switch f.Kind() {
+		case reflect.Ptr:
+			if f.IsNil() {
+				continue
+			}
+			if f.Elem().Kind() == reflect.Struct {
+				if err := walkStruct(f.Elem(), p, fn); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := fn(p, f.Elem().Interface()); err != nil {
+				return err
+			}
+		case reflect.Map:
+			if err := walkList(f, p, fn); err != nil {
+				return err
+			}
+		case reflect.Interface, reflect.Slice:
+			if f.IsNil() || (f.Kind() == reflect.Slice && f.Len() == 0) {
+				continue
+			}
+			if err := fn(p, f.Interface()); err != nil {
+				return err
+			}
+		default:
+			// Enumerations and YANG empty leaves are not pointers, their
+			// zero value means unset.
+			if f.IsZero() {
+				continue
+			}
+			if err := fn(p, f.Interface()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// keyEscaper escapes the characters of a key value that end it in a gNMI path
+// string, as ygot.PathToString does.
+var keyEscaper = strings.NewReplacer(`\`, `\\`, "]", `\]`)
+
+// elemsString renders elems as a gNMI path string, with the keys of each
+// element in name order and their values escaped.
+func elemsString(elems []*gnmi.PathElem) string {
+	var b strings.Builder
+	for _, e := range elems {
+		b.WriteString("/" + e.GetName())
+		names := make([]string, 0, len(e.GetKey()))
+		for name := range e.GetKey() {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(&b, "[%s=%s]", name, keyEscaper.Replace(e.GetKey()[name]))
+		}
+	}
+	return b.String()
+}
+
+// walkList visits the members of a keyed YANG list in key order, adding the
+// keys of each member to the last element of its path.
+func walkList(m reflect.Value, prefix []*gnmi.PathElem, fn func([]*gnmi.PathElem, interface{}) error) error {
+	type member struct {
+		path []*gnmi.PathElem
+		key  string
+		val  reflect.Value
+	}
+	last := prefix[len(prefix)-1]
+	var members []member
+	for _, k := range m.MapKeys() {
+		e := m.MapIndex(k)
+		keys, err := ygot.PathKeyFromStruct(e)
+		if err != nil {
+			return fmt.Errorf("cannot read keys of %s: %w", elemsString(prefix), err)
+		}
+		p := append(prefix[:len(prefix)-1:len(prefix)-1], &gnmi.PathElem{Name: last.GetName(), Key: keys})
+		members = append(members, member{p, elemsString(p[len(p)-1:]), e})
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].key < members[j].key })
+
+	for _, mem := range members {
+		if err := walkStruct(mem.val.Elem(), mem.path, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
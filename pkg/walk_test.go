@@ -0,0 +1,93 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+// augmentDevice returns the device built by the augment example.
+func augmentDevice() *Device {
+	d := &Device{}
+	iface := d.GetOrCreateInterface()
+	iface.Name = ygot.String("eth0")
+	iface.Mtu = ygot.Uint16(1500)
+	iface.Priority = ygot.Uint8(12)
+	iface.Status = NetworkDevice_Interface_Status_up
+	iface.Bandwidth = ygot.Uint32(1000)
+	return d
+}
+
+func TestWalk(t *testing.T) {
+	visited := map[string]interface{}{}
+	err := Walk(augmentDevice(), func(path string, value interface{}) error {
+		visited[path] = value
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Walk() error = %v", err)
+	}
+	if len(visited) != 5 {
+		t.Errorf("Walk() visited %d leaves, want 5: %v", len(visited), visited)
+	}
+	if got := visited["/interface/status"]; got != NetworkDevice_Interface_Status_up {
+		t.Errorf("/interface/status = %#v, want %#v", got, NetworkDevice_Interface_Status_up)
+	}
+	if got := visited["/interface/mtu"]; got != uint16(1500) {
+		t.Errorf("/interface/mtu = %#v, want uint16(1500)", got)
+	}
+}
+
+func TestWalkStopsOnError(t *testing.T) {
+	calls := 0
+	err := Walk(augmentDevice(), func(string, interface{}) error {
+		calls++
+		return errUnitTest
+	})
+	if !errors.Is(err, errUnitTest) {
+		t.Errorf("Walk() error = %v, want %v", err, errUnitTest)
+	}
+	if calls != 1 {
+		t.Errorf("Walk() called fn %d times, want 1", calls)
+	}
+}
+
+func TestWalkNilDevice(t *testing.T) {
+	err := Walk(nil, func(path string, _ interface{}) error {
+		t.Errorf("Walk(nil) visited %s", path)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Walk(nil) error = %v", err)
+	}
+}
+
+func TestWalkEscapesListKeys(t *testing.T) {
+	d := augmentDevice()
+	want := map[string]bool{`a]b`: true, `c\d`: true}
+	for k := range want {
+		d.Interface.SetTag(k, "v")
+	}
+
+	got := map[string]bool{}
+	if err := Walk(d, func(path string, _ interface{}) error {
+		p, err := ygot.StringToStructuredPath(path)
+		if err != nil {
+			return fmt.Errorf("path %s does not parse: %w", path, err)
+		}
+		for _, e := range p.GetElem() {
+			if e.GetName() == "tag" {
+				got[e.GetKey()["key"]] = true
+			}
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("Walk() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("tag keys parsed back from the Walk paths = %v, want %v", got, want)
+	}
+}
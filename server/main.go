@@ -0,0 +1,31 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net"
+
+	network "github.com/nleiva/go-yang-basics/pkg"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	addr := flag.String("addr", ":9339", "address to listen on for gNMI requests")
+	flag.Parse()
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		fmt.Printf("Error listening on %s: %v\n", *addr, err)
+		return
+	}
+
+	// Serve an empty device that clients populate with Set requests
+	s := grpc.NewServer()
+	gnmi.RegisterGNMIServer(s, newServer(&network.Device{}))
+
+	fmt.Printf("gNMI server listening on %s\n", lis.Addr())
+	if err := s.Serve(lis); err != nil {
+		fmt.Printf("Error serving gNMI: %v\n", err)
+	}
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	network "github.com/nleiva/go-yang-basics/pkg"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// server is a gNMI target that serves the configuration of a single
+// in-memory device.
+type server struct {
+	gnmi.UnimplementedGNMIServer
+
+	mu     sync.RWMutex
+	device *network.Device
+}
+
+func newServer(d *network.Device) *server {
+	return &server{device: d}
+}
+
+// Capabilities reports the model and encoding the server supports.
+func (s *server) Capabilities(context.Context, *gnmi.CapabilityRequest) (*gnmi.CapabilityResponse, error) {
+	return network.Capabilities(), nil
+}
+
+// Get returns the leaves of the device under each requested path, or the
+// whole device when no path is given.
+func (s *server) Get(_ context.Context, req *gnmi.GetRequest) (*gnmi.GetResponse, error) {
+	s.mu.RLock()
+	setReq, err := network.ToSetRequest(s.device)
+	s.mu.RUnlock()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot render device: %v", err)
+	}
+
+	var paths []*gnmi.Path
+	for _, p := range req.GetPath() {
+		paths = append(paths, &gnmi.Path{Elem: append(append([]*gnmi.PathElem{}, req.GetPrefix().GetElem()...), p.GetElem()...)})
+	}
+
+	n := &gnmi.Notification{Timestamp: time.Now().UnixNano()}
+	for _, u := range setReq.GetUpdate() {
+		if len(paths) == 0 || matchesAny(u.GetPath(), paths) {
+			n.Update = append(n.Update, u)
+		}
+	}
+	return &gnmi.GetResponse{Notification: []*gnmi.Notification{n}}, nil
+}
+
+// Set applies the request to the device, which is only changed if the result
+// is valid. Requests that cannot be applied or yield an invalid device fail
+// with codes.InvalidArgument, any other failure with codes.Internal.
+func (s *server) Set(_ context.Context, req *gnmi.SetRequest) (*gnmi.SetResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := network.ApplySetRequest(s.device, req); err != nil {
+		if errors.Is(err, network.ErrValidation) || errors.Is(err, network.ErrInvalidRequest) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	resp := &gnmi.SetResponse{Prefix: req.GetPrefix(), Timestamp: time.Now().UnixNano()}
+	for _, p := range req.GetDelete() {
+		resp.Response = append(resp.Response, &gnmi.UpdateResult{Path: p, Op: gnmi.UpdateResult_DELETE})
+	}
+	for _, u := range req.GetReplace() {
+		resp.Response = append(resp.Response, &gnmi.UpdateResult{Path: u.GetPath(), Op: gnmi.UpdateResult_REPLACE})
+	}
+	for _, u := range req.GetUpdate() {
+		resp.Response = append(resp.Response, &gnmi.UpdateResult{Path: u.GetPath(), Op: gnmi.UpdateResult_UPDATE})
+	}
+	return resp, nil
+}
+
+// matchesAny reports whether path is equal to or below any of the paths.
+func matchesAny(path *gnmi.Path, paths []*gnmi.Path) bool {
+	for _, p := range paths {
+		if len(p.GetElem()) > len(path.GetElem()) {
+			continue
+		}
+		match := true
+		for i, e := range p.GetElem() {
+			if e.GetName() != path.GetElem()[i].GetName() {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	network "github.com/nleiva/go-yang-basics/pkg"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// setRequest returns the SetRequest that sets the leaves of the interface
+// built by fn.
+func setRequest(t *testing.T, fn func(*network.NetworkDevice_Interface)) *gnmi.SetRequest {
+	t.Helper()
+	d := &network.Device{}
+	fn(d.GetOrCreateInterface())
+	req, err := network.ToSetRequest(d)
+	if err != nil {
+		t.Fatalf("ToSetRequest() error = %v", err)
+	}
+	return req
+}
+
+func TestCapabilities(t *testing.T) {
+	s := newServer(&network.Device{})
+	resp, err := s.Capabilities(context.Background(), &gnmi.CapabilityRequest{})
+	if err != nil {
+		t.Fatalf("Capabilities() error = %v", err)
+	}
+	models := resp.GetSupportedModels()
+	if len(models) != 1 || models[0].GetName() != "network-device" || models[0].GetVersion() != network.ModuleRevision() {
+		t.Errorf("Capabilities() models = %v, want network-device at %s", models, network.ModuleRevision())
+	}
+}
+
+func TestSetInvalidPriority(t *testing.T) {
+	s := newServer(&network.Device{})
+	req := setRequest(t, func(i *network.NetworkDevice_Interface) { i.Priority = ygot.Uint8(7) })
+
+	_, err := s.Set(context.Background(), req)
+	if got := status.Code(err); got != codes.InvalidArgument {
+		t.Fatalf("Set() error = %v, want code %v", err, codes.InvalidArgument)
+	}
+	if s.device.GetInterface().GetPriority() != 0 {
+		t.Errorf("device priority = %d after a failed Set, want unset", s.device.GetInterface().GetPriority())
+	}
+}
+
+func TestSetThenGet(t *testing.T) {
+	s := newServer(&network.Device{})
+	req := setRequest(t, func(i *network.NetworkDevice_Interface) {
+		i.Name = ygot.String("eth0")
+		i.Mtu = ygot.Uint16(9000)
+	})
+
+	resp, err := s.Set(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if len(resp.GetResponse()) != 2 {
+		t.Errorf("Set() = %d results, want 2", len(resp.GetResponse()))
+	}
+
+	get, err := s.Get(context.Background(), &gnmi.GetRequest{
+		Path: []*gnmi.Path{{Elem: []*gnmi.PathElem{{Name: "interface"}, {Name: "mtu"}}}},
+	})
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	updates := get.GetNotification()[0].GetUpdate()
+	if len(updates) != 1 || updates[0].GetVal().GetUintVal() != 9000 {
+		t.Errorf("Get(/interface/mtu) = %v, want a single update of 9000", updates)
+	}
+}
+
+func TestSetUnknownPath(t *testing.T) {
+	s := newServer(&network.Device{})
+	req := &gnmi.SetRequest{Update: []*gnmi.Update{{
+		Path: &gnmi.Path{Elem: []*gnmi.PathElem{{Name: "interface"}, {Name: "speed-mbps"}}},
+		Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: 100}},
+	}}}
+	if _, err := s.Set(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Set() error = %v, want code %v", err, codes.InvalidArgument)
+	}
+}
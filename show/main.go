@@ -0,0 +1,45 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	network "github.com/nleiva/go-yang-basics/pkg"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "ERROR: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run prints the device configuration in the JSON file named in args to w,
+// as a tree or, with -json, as canonical RFC7951 JSON.
+func run(args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("show", flag.ContinueOnError)
+	asJSON := fs.Bool("json", false, "print canonical RFC7951 JSON instead of a tree")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("usage: show [-json] <file.json>")
+	}
+
+	data, err := os.ReadFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	device := &network.Device{}
+	if err := network.Parse(data, device); err != nil {
+		return fmt.Errorf("cannot parse %s: %w", fs.Arg(0), err)
+	}
+
+	if *asJSON {
+		return network.EmitJSONTo(w, device, nil)
+	}
+	_, err = io.WriteString(w, network.Tree(device))
+	return err
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nleiva/go-yang-basics/pkg/internaltest"
+)
+
+func TestRun(t *testing.T) {
+	input := filepath.Join("testdata", "augment.json")
+	for _, tt := range []struct {
+		desc   string
+		args   []string
+		golden string
+		json   bool
+	}{
+		{"tree", []string{input}, "augment.tree.golden", false},
+		{"json", []string{"-json", input}, "augment.json.golden", true},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := run(tt.args, &buf); err != nil {
+				t.Fatalf("run(%v) error = %v", tt.args, err)
+			}
+			golden := filepath.Join("testdata", tt.golden)
+			if tt.json {
+				internaltest.AssertJSON(t, buf.Bytes(), golden)
+				return
+			}
+			if *internaltest.Update {
+				if err := os.WriteFile(golden, buf.Bytes(), 0o644); err != nil {
+					t.Fatalf("cannot update golden file: %v", err)
+				}
+				return
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("cannot read golden file (run with -update to create it): %v", err)
+			}
+			if !bytes.Equal(buf.Bytes(), want) {
+				t.Errorf("run(%v) =\n%s\nwant:\n%s", tt.args, buf.Bytes(), want)
+			}
+		})
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	for _, tt := range []struct {
+		desc string
+		args []string
+	}{
+		{"no file", nil},
+		{"two files", []string{"a.json", "b.json"}},
+		{"missing file", []string{filepath.Join("testdata", "missing.json")}},
+		{"unknown flag", []string{"-yaml", filepath.Join("testdata", "augment.json")}},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := run(tt.args, &buf); err == nil {
+				t.Errorf("run(%v) = %s, want an error", tt.args, buf.Bytes())
+			}
+		})
+	}
+}
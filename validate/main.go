@@ -17,7 +17,7 @@ func main() {
 		fmt.Printf("ERROR: Can't unmarshal JSON: %v\n", err)
 	}
 
-	err := device1.Validate()
+	err := network.ValidateDevice(&device1)
 	if err != nil {
 		fmt.Printf("ERROR: Parsed input is not valid: %v\n", err)
 	} else {
@@ -30,7 +30,7 @@ func main() {
 	iface := device2.GetOrCreateInterface()
 	iface.Priority = ygot.Uint8(25) // Invalid: should be 1-5 or 10-15
 
-	err = device2.Validate()
+	err = network.ValidateDevice(&device2)
 	if err != nil {
 		fmt.Printf("ERROR: Built instance is not valid: %v\n", err)
 	} else {
@@ -45,7 +45,7 @@ func main() {
 	iface3.Mtu = ygot.Uint16(1500)
 	iface3.Priority = ygot.Uint8(12) // Valid: within 10-15 range
 
-	err = device3.Validate()
+	err = network.ValidateDevice(&device3)
 	if err != nil {
 		fmt.Printf("ERROR: Configuration is not valid: %v\n", err)
 	} else {